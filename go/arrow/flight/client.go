@@ -0,0 +1,174 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flight
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/apache/arrow/go/arrow"
+	pb "github.com/apache/arrow/go/arrow/flight/internal/flight"
+	"github.com/apache/arrow/go/arrow/internal/arrio"
+	"github.com/apache/arrow/go/arrow/memory"
+	"google.golang.org/grpc"
+)
+
+// Client is a convenience wrapper around the generated Flight gRPC client
+// that hands back arrio.Reader / arrio.Writer for the streaming RPCs,
+// instead of raw FlightData messages.
+type Client struct {
+	cc  *grpc.ClientConn
+	c   pb.FlightServiceClient
+	mem memory.Allocator
+
+	// ownsConn is set when NewClient dialed cc, so that Close closes it.
+	ownsConn bool
+}
+
+// NewClientWithConn builds a Client on top of an already-established
+// connection. The caller owns cc and is responsible for closing it.
+func NewClientWithConn(cc *grpc.ClientConn, mem memory.Allocator) *Client {
+	if mem == nil {
+		mem = memory.DefaultAllocator
+	}
+	return &Client{cc: cc, c: pb.NewFlightServiceClient(cc), mem: mem}
+}
+
+// NewClient dials addr and returns a Client using it. Any grpc.DialOption
+// is forwarded to grpc.Dial.
+func NewClient(addr string, mem memory.Allocator, opts ...grpc.DialOption) (*Client, error) {
+	cc, err := grpc.Dial(addr, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("flight: failed to connect to %s: %w", addr, err)
+	}
+	client := NewClientWithConn(cc, mem)
+	client.ownsConn = true
+	return client, nil
+}
+
+// Close tears down the underlying connection, if this Client dialed it
+// itself via NewClient.
+func (c *Client) Close() error {
+	if !c.ownsConn {
+		return nil
+	}
+	return c.cc.Close()
+}
+
+// Handshake performs the authentication handshake, sending payload and
+// returning whatever the server replies with.
+func (c *Client) Handshake(ctx context.Context, payload []byte) ([]byte, error) {
+	stream, err := c.c.Handshake(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := stream.Send(&HandshakeRequest{Payload: payload}); err != nil {
+		return nil, err
+	}
+	if err := stream.CloseSend(); err != nil {
+		return nil, err
+	}
+	resp, err := stream.Recv()
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetPayload(), nil
+}
+
+// ListFlights lists the flights matching criteria.
+func (c *Client) ListFlights(ctx context.Context, criteria *Criteria) (pb.FlightService_ListFlightsClient, error) {
+	return c.c.ListFlights(ctx, criteria)
+}
+
+// GetFlightInfo resolves desc into the FlightInfo describing how to
+// retrieve it.
+func (c *Client) GetFlightInfo(ctx context.Context, desc *FlightDescriptor) (*FlightInfo, error) {
+	return c.c.GetFlightInfo(ctx, desc)
+}
+
+// GetSchema resolves desc into just its schema, without the rest of a
+// FlightInfo.
+func (c *Client) GetSchema(ctx context.Context, desc *FlightDescriptor) (*SchemaResult, error) {
+	return c.c.GetSchema(ctx, desc)
+}
+
+// DoGet retrieves the stream identified by ticket, returning an arrio.Reader
+// that yields array.Record values decoded straight out of the gRPC frames.
+func (c *Client) DoGet(ctx context.Context, ticket *Ticket) (arrio.Reader, error) {
+	stream, err := c.c.DoGet(ctx, ticket)
+	if err != nil {
+		return nil, err
+	}
+	return newRecordBatchReader(stream, c.mem)
+}
+
+// DoPut opens a stream to upload schema to the server under the given
+// descriptor, returning a RecordWriter to push record batches and a
+// channel of the PutResult the server sends back for each one.
+func (c *Client) DoPut(ctx context.Context, desc *FlightDescriptor, schema *arrow.Schema) (RecordWriter, <-chan *PutResult, error) {
+	stream, err := c.c.DoPut(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	w, err := newRecordBatchWriter(stream, desc, schema)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	results := make(chan *PutResult)
+	go func() {
+		defer close(results)
+		for {
+			res, err := stream.Recv()
+			if err != nil {
+				return
+			}
+			results <- res
+		}
+	}()
+	return w, results, nil
+}
+
+// DoExchange opens a bidirectional stream with the server, returning both
+// a RecordWriter to push record batches to the server and an arrio.Reader
+// to consume whatever it sends back.
+func (c *Client) DoExchange(ctx context.Context, desc *FlightDescriptor, schema *arrow.Schema) (RecordWriter, arrio.Reader, error) {
+	stream, err := c.c.DoExchange(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	w, err := newRecordBatchWriter(stream, desc, schema)
+	if err != nil {
+		return nil, nil, err
+	}
+	r, err := newRecordBatchReader(stream, c.mem)
+	if err != nil {
+		return nil, nil, err
+	}
+	return w, r, nil
+}
+
+// DoAction executes action against the server, returning the stream of
+// opaque Result values it produces.
+func (c *Client) DoAction(ctx context.Context, action *Action) (pb.FlightService_DoActionClient, error) {
+	return c.c.DoAction(ctx, action)
+}
+
+// ListActions enumerates the actions the server supports.
+func (c *Client) ListActions(ctx context.Context) (pb.FlightService_ListActionsClient, error) {
+	return c.c.ListActions(ctx, &Empty{})
+}
@@ -0,0 +1,38 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package flight implements Arrow Flight, a gRPC-based protocol for
+// streaming Arrow record batches between client and server with minimal
+// serialization overhead.
+//
+// A Flight service is described by the FlightService interface, which
+// mirrors the RPCs defined in Flight.proto: Handshake, ListFlights,
+// GetFlightInfo, GetSchema, DoGet, DoPut, DoAction, ListActions and
+// DoExchange. Server wraps a FlightService implementation and exposes it
+// over gRPC; Client talks to a remote Flight service and hands back
+// arrio.Reader / arrio.Writer streams so that callers consume and produce
+// array.Record values directly, without dealing with the underlying
+// FlightData frames.
+//
+// The protobuf and gRPC stubs live in the internal/flight package and are
+// checked in. They are hand-written stand-ins for protoc output (see the
+// header of internal/flight/Flight.pb.go); the go:generate directive below
+// replaces them with real protoc output:
+//
+//	protoc --go_out=paths=source_relative:./internal/flight --go-grpc_out=paths=source_relative:./internal/flight Flight.proto
+package flight
+
+//go:generate protoc --go_out=paths=source_relative:./internal/flight --go-grpc_out=paths=source_relative:./internal/flight Flight.proto
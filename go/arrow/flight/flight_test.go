@@ -0,0 +1,100 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flight_test
+
+import (
+	"context"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/flight"
+	"github.com/apache/arrow/go/arrow/memory"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+// singleBatchService serves exactly one record batch from DoGet, regardless
+// of the ticket it's asked for, and otherwise relies on BaseFlightService
+// to answer Unimplemented.
+type singleBatchService struct {
+	flight.BaseFlightService
+
+	schema *arrow.Schema
+	rec    array.Record
+}
+
+func (s *singleBatchService) DoGet(_ *flight.Ticket, stream flight.DoGetServer) error {
+	w, err := flight.RecordBatchWriter(stream, s.schema)
+	if err != nil {
+		return err
+	}
+	if err := w.Write(s.rec); err != nil {
+		return err
+	}
+	return w.Close()
+}
+
+// TestDoGetRoundTrip starts a Flight server on localhost, requests a stream
+// via DoGet, and checks that the record batch comes back unchanged.
+func TestDoGetRoundTrip(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.DefaultAllocator)
+	defer mem.AssertSize(t, 0)
+
+	// The schema mixes a plain fixed-width column with a nullable string
+	// column, so the record's body spans several buffers (validity
+	// bitmaps, offsets, and data) rather than just one - this is what
+	// exposed the writer/reader re-framing bug this test now guards
+	// against.
+	schema := arrow.NewSchema([]arrow.Field{
+		{Name: "ints", Type: arrow.PrimitiveTypes.Int32},
+		{Name: "strs", Type: arrow.BinaryTypes.String, Nullable: true},
+	}, nil)
+
+	b := array.NewRecordBuilder(mem, schema)
+	defer b.Release()
+	b.Field(0).(*array.Int32Builder).AppendValues([]int32{1, 2, 3}, nil)
+	b.Field(1).(*array.StringBuilder).AppendValues([]string{"foo", "", "bar"}, []bool{true, false, true})
+	rec := b.NewRecord()
+	defer rec.Release()
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	srv := flight.NewServer(&singleBatchService{schema: schema, rec: rec})
+	go srv.Serve(lis)
+	defer srv.Stop()
+
+	client, err := flight.NewClient(lis.Addr().String(), mem, grpc.WithInsecure())
+	require.NoError(t, err)
+	defer client.Close()
+
+	r, err := client.DoGet(context.Background(), &flight.Ticket{Ticket: []byte("ignored")})
+	require.NoError(t, err)
+
+	got, err := r.Read()
+	require.NoError(t, err)
+	defer got.Release()
+
+	assert.True(t, array.RecordEqual(rec, got))
+
+	_, err = r.Read()
+	assert.Equal(t, io.EOF, err)
+}
@@ -0,0 +1,158 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flightsql
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/apache/arrow/go/arrow/flight"
+	sqlpb "github.com/apache/arrow/go/arrow/flight/flightsql/internal/flightsql"
+	"github.com/apache/arrow/go/arrow/internal/arrio"
+	"github.com/apache/arrow/go/arrow/memory"
+	proto "github.com/golang/protobuf/proto"
+	"google.golang.org/grpc"
+)
+
+// Client talks Flight SQL to a flightsql.Server (or any other compliant
+// Flight SQL server) over an underlying flight.Client.
+type Client struct {
+	fl *flight.Client
+}
+
+// NewClient dials addr and returns a Client using it.
+func NewClient(addr string, mem memory.Allocator, opts ...grpc.DialOption) (*Client, error) {
+	fl, err := flight.NewClient(addr, mem, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &Client{fl: fl}, nil
+}
+
+// Close tears down the underlying connection.
+func (c *Client) Close() error {
+	return c.fl.Close()
+}
+
+// Execute runs query and returns the FlightInfo describing how to retrieve
+// its result set.
+func (c *Client) Execute(ctx context.Context, query string) (*flight.FlightInfo, error) {
+	cmd, err := packCommand("CommandStatementQuery", &sqlpb.CommandStatementQuery{Query: query})
+	if err != nil {
+		return nil, err
+	}
+	return c.fl.GetFlightInfo(ctx, &flight.FlightDescriptor{
+		Type: flight.DescriptorCMD,
+		Cmd:  cmd,
+	})
+}
+
+// ExecuteUpdate runs an update statement (INSERT/UPDATE/DELETE/DDL) and
+// returns the number of rows it affected.
+func (c *Client) ExecuteUpdate(ctx context.Context, query string) (int64, error) {
+	cmd, err := packCommand("CommandStatementUpdate", &sqlpb.CommandStatementUpdate{Query: query})
+	if err != nil {
+		return 0, err
+	}
+	w, results, err := c.fl.DoPut(ctx, &flight.FlightDescriptor{Type: flight.DescriptorCMD, Cmd: cmd}, nil)
+	if err != nil {
+		return 0, err
+	}
+	if err := w.Close(); err != nil {
+		return 0, err
+	}
+	res, ok := <-results
+	if !ok {
+		return 0, fmt.Errorf("flightsql: server closed DoPut without a result")
+	}
+	var out sqlpb.DoPutUpdateResult
+	if err := proto.Unmarshal(res.GetAppMetadata(), &out); err != nil {
+		return 0, err
+	}
+	return out.GetRecordCount(), nil
+}
+
+// PreparedStatement is a SQL statement prepared on the server for repeated
+// execution with different parameters.
+type PreparedStatement struct {
+	client *Client
+	handle []byte
+}
+
+// Prepare creates a PreparedStatement for query.
+func (c *Client) Prepare(ctx context.Context, query string) (*PreparedStatement, error) {
+	body, err := proto.Marshal(&sqlpb.ActionCreatePreparedStatementRequest{Query: query})
+	if err != nil {
+		return nil, err
+	}
+	stream, err := c.fl.DoAction(ctx, &flight.Action{Type: "CreatePreparedStatement", Body: body})
+	if err != nil {
+		return nil, err
+	}
+	res, err := stream.Recv()
+	if err != nil {
+		return nil, err
+	}
+	var out sqlpb.ActionCreatePreparedStatementResult
+	if err := proto.Unmarshal(res.GetBody(), &out); err != nil {
+		return nil, err
+	}
+	return &PreparedStatement{client: c, handle: out.GetPreparedStatementHandle()}, nil
+}
+
+// Execute runs the prepared statement and returns the FlightInfo
+// describing how to retrieve its result set.
+func (p *PreparedStatement) Execute(ctx context.Context) (*flight.FlightInfo, error) {
+	cmd, err := packCommand("CommandPreparedStatementQuery", &sqlpb.CommandPreparedStatementQuery{
+		PreparedStatementHandle: p.handle,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return p.client.fl.GetFlightInfo(ctx, &flight.FlightDescriptor{Type: flight.DescriptorCMD, Cmd: cmd})
+}
+
+// Close releases the prepared statement on the server.
+func (p *PreparedStatement) Close(ctx context.Context) error {
+	body, err := proto.Marshal(&sqlpb.ActionClosePreparedStatementRequest{PreparedStatementHandle: p.handle})
+	if err != nil {
+		return err
+	}
+	stream, err := p.client.fl.DoAction(ctx, &flight.Action{Type: "ClosePreparedStatement", Body: body})
+	if err != nil {
+		return err
+	}
+	for {
+		_, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// DoGet retrieves the result stream described by a FlightInfo returned from
+// Execute, returning an arrio.Reader of array.Record.
+func (c *Client) DoGet(ctx context.Context, info *flight.FlightInfo) (arrio.Reader, error) {
+	if len(info.GetEndpoint()) == 0 {
+		return nil, fmt.Errorf("flightsql: FlightInfo has no endpoints")
+	}
+	return c.fl.DoGet(ctx, info.GetEndpoint()[0].GetTicket())
+}
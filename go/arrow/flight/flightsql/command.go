@@ -0,0 +1,79 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flightsql
+
+import (
+	"fmt"
+	"strings"
+
+	sqlpb "github.com/apache/arrow/go/arrow/flight/flightsql/internal/flightsql"
+	proto "github.com/golang/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+// typeURLPrefix matches what protoc-gen-go and the reference Flight SQL
+// implementations use when packing a command into a google.protobuf.Any.
+const typeURLPrefix = "type.googleapis.com/arrow.flight.protocol.sql."
+
+// packCommand serializes cmd and wraps it in a google.protobuf.Any, as
+// Flight SQL requires for every FlightDescriptor.Cmd / Ticket.Ticket. name
+// is the unqualified message name, e.g. "CommandStatementQuery".
+func packCommand(name string, cmd proto.Message) ([]byte, error) {
+	value, err := proto.Marshal(cmd)
+	if err != nil {
+		return nil, fmt.Errorf("flightsql: failed to marshal %s: %w", name, err)
+	}
+	any := &anypb.Any{TypeUrl: typeURLPrefix + name, Value: value}
+	return proto.Marshal(any)
+}
+
+// unpackCommand reverses packCommand: it unwraps the Any in data and, if
+// its type_url matches name, unmarshals the payload into cmd. It returns an
+// error if data isn't a command of the expected type.
+func unpackCommand(data []byte, name string, cmd proto.Message) error {
+	any := &anypb.Any{}
+	if err := proto.Unmarshal(data, any); err != nil {
+		return fmt.Errorf("flightsql: failed to unmarshal command envelope: %w", err)
+	}
+	want := typeURLPrefix + name
+	if any.TypeUrl != want {
+		return fmt.Errorf("flightsql: expected command %s, got %s", want, any.TypeUrl)
+	}
+	return proto.Unmarshal(any.Value, cmd)
+}
+
+// commandName returns the unqualified message name packed into data's
+// google.protobuf.Any envelope, e.g. "CommandStatementQuery", without
+// unmarshaling the payload itself. Servers use this to dispatch an
+// incoming FlightDescriptor/Ticket to the right handler.
+func commandName(data []byte) (string, error) {
+	any := &anypb.Any{}
+	if err := proto.Unmarshal(data, any); err != nil {
+		return "", fmt.Errorf("flightsql: failed to unmarshal command envelope: %w", err)
+	}
+	if !strings.HasPrefix(any.TypeUrl, typeURLPrefix) {
+		return "", fmt.Errorf("flightsql: type_url %q is not a Flight SQL command", any.TypeUrl)
+	}
+	return strings.TrimPrefix(any.TypeUrl, typeURLPrefix), nil
+}
+
+// PackTicketStatementQuery builds the Ticket.Ticket bytes a Server
+// implementation should return from GetFlightInfoStatement, identifying the
+// query result set by statementHandle (an opaque, server-defined value).
+func PackTicketStatementQuery(statementHandle []byte) ([]byte, error) {
+	return packCommand("TicketStatementQuery", &sqlpb.TicketStatementQuery{StatementHandle: statementHandle})
+}
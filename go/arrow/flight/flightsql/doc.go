@@ -0,0 +1,40 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package flightsql implements Flight SQL, a protocol for interacting with
+// SQL databases over Arrow Flight. It introduces no new RPCs: every
+// command (CommandStatementQuery, CommandGetTables, ...) is a protobuf
+// message packed into a google.protobuf.Any and carried inside the
+// FlightDescriptor.Cmd of a GetFlightInfo call or the Ticket.Ticket of a
+// DoGet call, so that ordinary Flight clients and servers can carry it
+// without any protocol changes of their own.
+//
+// Client wraps a flight.Client with methods that pack and unpack these
+// commands; BaseServer is embedded by a driver's own flightsql.Server
+// implementation, which only needs to answer the handful of methods
+// describing its SQL engine (GetFlightInfoStatement, DoGetStatement,
+// GetTables, ...) and, through NewServer, gets Flight's
+// DoGet/GetFlightInfo/DoAction dispatch for free.
+//
+// The protobuf stubs live in internal/flightsql and are checked in. They are
+// hand-written stand-ins for protoc output (see the header of
+// internal/flightsql/FlightSql.pb.go); the go:generate directive below
+// replaces them with real protoc output:
+//
+//	protoc --go_out=paths=source_relative:./internal/flightsql FlightSql.proto
+package flightsql
+
+//go:generate protoc --go_out=paths=source_relative:./internal/flightsql FlightSql.proto
@@ -0,0 +1,107 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package example provides a minimal, in-memory Flight SQL backend: a
+// single named table with a fixed schema and row set, used by the
+// flightsql package's tests to exercise a full client/server round trip
+// without depending on an actual SQL engine.
+package example
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/flight"
+	"github.com/apache/arrow/go/arrow/flight/flightsql"
+	sqlpb "github.com/apache/arrow/go/arrow/flight/flightsql/internal/flightsql"
+	"github.com/apache/arrow/go/arrow/internal/arrio"
+)
+
+// Table is a trivial Flight SQL backend serving the contents of a single
+// in-memory record regardless of the query text, so that tests can exercise
+// the full Execute -> FlightInfo -> DoGet round trip.
+type Table struct {
+	flightsql.BaseServer
+
+	Name   string
+	Schema *arrow.Schema
+	Record array.Record
+
+	prepared map[string]string // handle -> query
+}
+
+// NewTable builds a Table serving rec under name.
+func NewTable(name string, schema *arrow.Schema, rec array.Record) *Table {
+	return &Table{
+		Name:     name,
+		Schema:   schema,
+		Record:   rec,
+		prepared: make(map[string]string),
+	}
+}
+
+func (t *Table) GetFlightInfoStatement(_ context.Context, cmd *sqlpb.CommandStatementQuery, desc *flight.FlightDescriptor) (*flight.FlightInfo, error) {
+	ticket, err := flightsql.PackTicketStatementQuery([]byte(cmd.GetQuery()))
+	if err != nil {
+		return nil, err
+	}
+	return &flight.FlightInfo{
+		FlightDescriptor: desc,
+		Endpoint: []*flight.FlightEndpoint{
+			{Ticket: &flight.Ticket{Ticket: ticket}},
+		},
+		TotalRecords: int64(t.Record.NumRows()),
+	}, nil
+}
+
+func (t *Table) DoGetStatement(context.Context, *sqlpb.TicketStatementQuery) (*arrow.Schema, arrio.Reader, error) {
+	t.Record.Retain()
+	return t.Schema, flightsql.NewSliceReader([]array.Record{t.Record}), nil
+}
+
+func (t *Table) CreatePreparedStatement(_ context.Context, req *sqlpb.ActionCreatePreparedStatementRequest) (*sqlpb.ActionCreatePreparedStatementResult, error) {
+	handle := sha256.Sum256([]byte(req.GetQuery()))
+	t.prepared[string(handle[:])] = req.GetQuery()
+	return &sqlpb.ActionCreatePreparedStatementResult{
+		PreparedStatementHandle: handle[:],
+	}, nil
+}
+
+func (t *Table) ClosePreparedStatement(_ context.Context, req *sqlpb.ActionClosePreparedStatementRequest) error {
+	delete(t.prepared, string(req.GetPreparedStatementHandle()))
+	return nil
+}
+
+func (t *Table) GetFlightInfoPreparedStatement(_ context.Context, cmd *sqlpb.CommandPreparedStatementQuery, desc *flight.FlightDescriptor) (*flight.FlightInfo, error) {
+	if _, ok := t.prepared[string(cmd.GetPreparedStatementHandle())]; !ok {
+		return nil, fmt.Errorf("flightsql/example: unknown prepared statement handle")
+	}
+	return &flight.FlightInfo{
+		FlightDescriptor: desc,
+		Endpoint: []*flight.FlightEndpoint{
+			{Ticket: &flight.Ticket{Ticket: desc.GetCmd()}},
+		},
+		TotalRecords: int64(t.Record.NumRows()),
+	}, nil
+}
+
+func (t *Table) DoGetPreparedStatement(context.Context, *sqlpb.CommandPreparedStatementQuery) (*arrow.Schema, arrio.Reader, error) {
+	t.Record.Retain()
+	return t.Schema, flightsql.NewSliceReader([]array.Record{t.Record}), nil
+}
@@ -0,0 +1,87 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flightsql_test
+
+import (
+	"context"
+	"io"
+	"net"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/flight/flightsql"
+	"github.com/apache/arrow/go/arrow/flight/flightsql/example"
+	"github.com/apache/arrow/go/arrow/memory"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+// TestExecuteRoundTrip starts a Flight SQL server backed by a single
+// in-memory table, runs a query through Client.Execute, and checks that
+// the record retrieved via DoGet matches what the table holds.
+func TestExecuteRoundTrip(t *testing.T) {
+	mem := memory.NewCheckedAllocator(memory.DefaultAllocator)
+	defer mem.AssertSize(t, 0)
+
+	// id has one body buffer (no nulls, fixed width); active adds a second,
+	// bit-packed buffer kind (a validity bitmap sitting directly alongside a
+	// 1-bit-per-value data buffer) that id's plain Int64 column doesn't
+	// exercise, so a query result with more than one column here still
+	// covers a distinct multi-buffer shape from flight's own round-trip
+	// test.
+	schema := arrow.NewSchema([]arrow.Field{
+		{Name: "id", Type: arrow.PrimitiveTypes.Int64},
+		{Name: "active", Type: arrow.FixedWidthTypes.Boolean, Nullable: true},
+	}, nil)
+	b := array.NewRecordBuilder(mem, schema)
+	defer b.Release()
+	b.Field(0).(*array.Int64Builder).AppendValues([]int64{1, 2, 3}, nil)
+	b.Field(1).(*array.BooleanBuilder).AppendValues([]bool{true, false, true}, []bool{true, false, true})
+	rec := b.NewRecord()
+	defer rec.Release()
+
+	table := example.NewTable("t", schema, rec)
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	srv := flightsql.NewServer(table)
+	go srv.Serve(lis)
+	defer srv.Stop()
+
+	client, err := flightsql.NewClient(lis.Addr().String(), mem, grpc.WithInsecure())
+	require.NoError(t, err)
+	defer client.Close()
+
+	ctx := context.Background()
+	info, err := client.Execute(ctx, "SELECT * FROM t")
+	require.NoError(t, err)
+	require.Len(t, info.GetEndpoint(), 1)
+
+	r, err := client.DoGet(ctx, info)
+	require.NoError(t, err)
+
+	got, err := r.Read()
+	require.NoError(t, err)
+	defer got.Release()
+	assert.True(t, array.RecordEqual(rec, got))
+
+	_, err = r.Read()
+	assert.Equal(t, io.EOF, err)
+}
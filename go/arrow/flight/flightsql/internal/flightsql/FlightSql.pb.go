@@ -0,0 +1,351 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Hand-written stand-in for protoc-gen-go output generated from
+// FlightSql.proto; see flight/internal/flight/Flight.pb.go for why this
+// isn't the literal output of a protoc run (no protoc or protoc-gen-go
+// available in this environment, and no network to fetch either) and what
+// regenerating it for real would require.
+
+package flightsql
+
+import (
+	proto "github.com/golang/protobuf/proto"
+)
+
+type TransactionEndAction int32
+
+const (
+	TransactionEndAction_TRANSACTION_END_UNSPECIFIED TransactionEndAction = 0
+	TransactionEndAction_TRANSACTION_END_COMMIT      TransactionEndAction = 1
+	TransactionEndAction_TRANSACTION_END_ROLLBACK    TransactionEndAction = 2
+)
+
+var TransactionEndAction_name = map[int32]string{
+	0: "TRANSACTION_END_UNSPECIFIED",
+	1: "TRANSACTION_END_COMMIT",
+	2: "TRANSACTION_END_ROLLBACK",
+}
+
+func (x TransactionEndAction) String() string {
+	return TransactionEndAction_name[int32(x)]
+}
+
+type CommandStatementQuery struct {
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+}
+
+func (m *CommandStatementQuery) Reset()         { *m = CommandStatementQuery{} }
+func (m *CommandStatementQuery) String() string { return proto.CompactTextString(m) }
+func (*CommandStatementQuery) ProtoMessage()    {}
+
+func (m *CommandStatementQuery) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
+}
+
+type TicketStatementQuery struct {
+	StatementHandle []byte `protobuf:"bytes,1,opt,name=statement_handle,json=statementHandle,proto3" json:"statement_handle,omitempty"`
+}
+
+func (m *TicketStatementQuery) Reset()         { *m = TicketStatementQuery{} }
+func (m *TicketStatementQuery) String() string { return proto.CompactTextString(m) }
+func (*TicketStatementQuery) ProtoMessage()    {}
+
+func (m *TicketStatementQuery) GetStatementHandle() []byte {
+	if m != nil {
+		return m.StatementHandle
+	}
+	return nil
+}
+
+type CommandPreparedStatementQuery struct {
+	PreparedStatementHandle []byte `protobuf:"bytes,1,opt,name=prepared_statement_handle,json=preparedStatementHandle,proto3" json:"prepared_statement_handle,omitempty"`
+}
+
+func (m *CommandPreparedStatementQuery) Reset()         { *m = CommandPreparedStatementQuery{} }
+func (m *CommandPreparedStatementQuery) String() string { return proto.CompactTextString(m) }
+func (*CommandPreparedStatementQuery) ProtoMessage()    {}
+
+func (m *CommandPreparedStatementQuery) GetPreparedStatementHandle() []byte {
+	if m != nil {
+		return m.PreparedStatementHandle
+	}
+	return nil
+}
+
+type ActionCreatePreparedStatementRequest struct {
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+}
+
+func (m *ActionCreatePreparedStatementRequest) Reset()         { *m = ActionCreatePreparedStatementRequest{} }
+func (m *ActionCreatePreparedStatementRequest) String() string { return proto.CompactTextString(m) }
+func (*ActionCreatePreparedStatementRequest) ProtoMessage()    {}
+
+func (m *ActionCreatePreparedStatementRequest) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
+}
+
+type ActionCreatePreparedStatementResult struct {
+	PreparedStatementHandle []byte `protobuf:"bytes,1,opt,name=prepared_statement_handle,json=preparedStatementHandle,proto3" json:"prepared_statement_handle,omitempty"`
+	DatasetSchema           []byte `protobuf:"bytes,2,opt,name=dataset_schema,json=datasetSchema,proto3" json:"dataset_schema,omitempty"`
+	ParameterSchema         []byte `protobuf:"bytes,3,opt,name=parameter_schema,json=parameterSchema,proto3" json:"parameter_schema,omitempty"`
+}
+
+func (m *ActionCreatePreparedStatementResult) Reset()         { *m = ActionCreatePreparedStatementResult{} }
+func (m *ActionCreatePreparedStatementResult) String() string { return proto.CompactTextString(m) }
+func (*ActionCreatePreparedStatementResult) ProtoMessage()    {}
+
+func (m *ActionCreatePreparedStatementResult) GetPreparedStatementHandle() []byte {
+	if m != nil {
+		return m.PreparedStatementHandle
+	}
+	return nil
+}
+
+func (m *ActionCreatePreparedStatementResult) GetDatasetSchema() []byte {
+	if m != nil {
+		return m.DatasetSchema
+	}
+	return nil
+}
+
+func (m *ActionCreatePreparedStatementResult) GetParameterSchema() []byte {
+	if m != nil {
+		return m.ParameterSchema
+	}
+	return nil
+}
+
+type ActionClosePreparedStatementRequest struct {
+	PreparedStatementHandle []byte `protobuf:"bytes,1,opt,name=prepared_statement_handle,json=preparedStatementHandle,proto3" json:"prepared_statement_handle,omitempty"`
+}
+
+func (m *ActionClosePreparedStatementRequest) Reset()         { *m = ActionClosePreparedStatementRequest{} }
+func (m *ActionClosePreparedStatementRequest) String() string { return proto.CompactTextString(m) }
+func (*ActionClosePreparedStatementRequest) ProtoMessage()    {}
+
+func (m *ActionClosePreparedStatementRequest) GetPreparedStatementHandle() []byte {
+	if m != nil {
+		return m.PreparedStatementHandle
+	}
+	return nil
+}
+
+type CommandStatementUpdate struct {
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+}
+
+func (m *CommandStatementUpdate) Reset()         { *m = CommandStatementUpdate{} }
+func (m *CommandStatementUpdate) String() string { return proto.CompactTextString(m) }
+func (*CommandStatementUpdate) ProtoMessage()    {}
+
+func (m *CommandStatementUpdate) GetQuery() string {
+	if m != nil {
+		return m.Query
+	}
+	return ""
+}
+
+type DoPutUpdateResult struct {
+	RecordCount int64 `protobuf:"varint,1,opt,name=record_count,json=recordCount,proto3" json:"record_count,omitempty"`
+}
+
+func (m *DoPutUpdateResult) Reset()         { *m = DoPutUpdateResult{} }
+func (m *DoPutUpdateResult) String() string { return proto.CompactTextString(m) }
+func (*DoPutUpdateResult) ProtoMessage()    {}
+
+func (m *DoPutUpdateResult) GetRecordCount() int64 {
+	if m != nil {
+		return m.RecordCount
+	}
+	return 0
+}
+
+type CommandGetCatalogs struct{}
+
+func (m *CommandGetCatalogs) Reset()         { *m = CommandGetCatalogs{} }
+func (m *CommandGetCatalogs) String() string { return proto.CompactTextString(m) }
+func (*CommandGetCatalogs) ProtoMessage()    {}
+
+type CommandGetSchemas struct {
+	Catalog             *string `protobuf:"bytes,1,opt,name=catalog,proto3,oneof" json:"catalog,omitempty"`
+	SchemaFilterPattern *string `protobuf:"bytes,2,opt,name=schema_filter_pattern,json=schemaFilterPattern,proto3,oneof" json:"schema_filter_pattern,omitempty"`
+}
+
+func (m *CommandGetSchemas) Reset()         { *m = CommandGetSchemas{} }
+func (m *CommandGetSchemas) String() string { return proto.CompactTextString(m) }
+func (*CommandGetSchemas) ProtoMessage()    {}
+
+func (m *CommandGetSchemas) GetCatalog() string {
+	if m != nil && m.Catalog != nil {
+		return *m.Catalog
+	}
+	return ""
+}
+
+func (m *CommandGetSchemas) GetSchemaFilterPattern() string {
+	if m != nil && m.SchemaFilterPattern != nil {
+		return *m.SchemaFilterPattern
+	}
+	return ""
+}
+
+type CommandGetTables struct {
+	Catalog                *string  `protobuf:"bytes,1,opt,name=catalog,proto3,oneof" json:"catalog,omitempty"`
+	SchemaFilterPattern    *string  `protobuf:"bytes,2,opt,name=schema_filter_pattern,json=schemaFilterPattern,proto3,oneof" json:"schema_filter_pattern,omitempty"`
+	TableNameFilterPattern *string  `protobuf:"bytes,3,opt,name=table_name_filter_pattern,json=tableNameFilterPattern,proto3,oneof" json:"table_name_filter_pattern,omitempty"`
+	TableTypes             []string `protobuf:"bytes,4,rep,name=table_types,json=tableTypes,proto3" json:"table_types,omitempty"`
+	IncludeSchema          bool     `protobuf:"varint,5,opt,name=include_schema,json=includeSchema,proto3" json:"include_schema,omitempty"`
+}
+
+func (m *CommandGetTables) Reset()         { *m = CommandGetTables{} }
+func (m *CommandGetTables) String() string { return proto.CompactTextString(m) }
+func (*CommandGetTables) ProtoMessage()    {}
+
+func (m *CommandGetTables) GetCatalog() string {
+	if m != nil && m.Catalog != nil {
+		return *m.Catalog
+	}
+	return ""
+}
+
+func (m *CommandGetTables) GetSchemaFilterPattern() string {
+	if m != nil && m.SchemaFilterPattern != nil {
+		return *m.SchemaFilterPattern
+	}
+	return ""
+}
+
+func (m *CommandGetTables) GetTableNameFilterPattern() string {
+	if m != nil && m.TableNameFilterPattern != nil {
+		return *m.TableNameFilterPattern
+	}
+	return ""
+}
+
+func (m *CommandGetTables) GetTableTypes() []string {
+	if m != nil {
+		return m.TableTypes
+	}
+	return nil
+}
+
+func (m *CommandGetTables) GetIncludeSchema() bool {
+	if m != nil {
+		return m.IncludeSchema
+	}
+	return false
+}
+
+type CommandGetPrimaryKeys struct {
+	Catalog *string `protobuf:"bytes,1,opt,name=catalog,proto3,oneof" json:"catalog,omitempty"`
+	Schema  *string `protobuf:"bytes,2,opt,name=schema,proto3,oneof" json:"schema,omitempty"`
+	Table   string  `protobuf:"bytes,3,opt,name=table,proto3" json:"table,omitempty"`
+}
+
+func (m *CommandGetPrimaryKeys) Reset()         { *m = CommandGetPrimaryKeys{} }
+func (m *CommandGetPrimaryKeys) String() string { return proto.CompactTextString(m) }
+func (*CommandGetPrimaryKeys) ProtoMessage()    {}
+
+func (m *CommandGetPrimaryKeys) GetCatalog() string {
+	if m != nil && m.Catalog != nil {
+		return *m.Catalog
+	}
+	return ""
+}
+
+func (m *CommandGetPrimaryKeys) GetSchema() string {
+	if m != nil && m.Schema != nil {
+		return *m.Schema
+	}
+	return ""
+}
+
+func (m *CommandGetPrimaryKeys) GetTable() string {
+	if m != nil {
+		return m.Table
+	}
+	return ""
+}
+
+type ActionBeginTransactionRequest struct{}
+
+func (m *ActionBeginTransactionRequest) Reset()         { *m = ActionBeginTransactionRequest{} }
+func (m *ActionBeginTransactionRequest) String() string { return proto.CompactTextString(m) }
+func (*ActionBeginTransactionRequest) ProtoMessage()    {}
+
+type ActionBeginTransactionResult struct {
+	TransactionId []byte `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+}
+
+func (m *ActionBeginTransactionResult) Reset()         { *m = ActionBeginTransactionResult{} }
+func (m *ActionBeginTransactionResult) String() string { return proto.CompactTextString(m) }
+func (*ActionBeginTransactionResult) ProtoMessage()    {}
+
+func (m *ActionBeginTransactionResult) GetTransactionId() []byte {
+	if m != nil {
+		return m.TransactionId
+	}
+	return nil
+}
+
+type ActionEndTransactionRequest struct {
+	TransactionId []byte               `protobuf:"bytes,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	Action        TransactionEndAction `protobuf:"varint,2,opt,name=action,proto3,enum=arrow.flight.protocol.sql.TransactionEndAction" json:"action,omitempty"`
+}
+
+func (m *ActionEndTransactionRequest) Reset()         { *m = ActionEndTransactionRequest{} }
+func (m *ActionEndTransactionRequest) String() string { return proto.CompactTextString(m) }
+func (*ActionEndTransactionRequest) ProtoMessage()    {}
+
+func (m *ActionEndTransactionRequest) GetTransactionId() []byte {
+	if m != nil {
+		return m.TransactionId
+	}
+	return nil
+}
+
+func (m *ActionEndTransactionRequest) GetAction() TransactionEndAction {
+	if m != nil {
+		return m.Action
+	}
+	return TransactionEndAction_TRANSACTION_END_UNSPECIFIED
+}
+
+func init() {
+	proto.RegisterEnum("arrow.flight.protocol.sql.TransactionEndAction", TransactionEndAction_name, nil)
+	proto.RegisterType((*CommandStatementQuery)(nil), "arrow.flight.protocol.sql.CommandStatementQuery")
+	proto.RegisterType((*TicketStatementQuery)(nil), "arrow.flight.protocol.sql.TicketStatementQuery")
+	proto.RegisterType((*CommandPreparedStatementQuery)(nil), "arrow.flight.protocol.sql.CommandPreparedStatementQuery")
+	proto.RegisterType((*ActionCreatePreparedStatementRequest)(nil), "arrow.flight.protocol.sql.ActionCreatePreparedStatementRequest")
+	proto.RegisterType((*ActionCreatePreparedStatementResult)(nil), "arrow.flight.protocol.sql.ActionCreatePreparedStatementResult")
+	proto.RegisterType((*ActionClosePreparedStatementRequest)(nil), "arrow.flight.protocol.sql.ActionClosePreparedStatementRequest")
+	proto.RegisterType((*CommandStatementUpdate)(nil), "arrow.flight.protocol.sql.CommandStatementUpdate")
+	proto.RegisterType((*DoPutUpdateResult)(nil), "arrow.flight.protocol.sql.DoPutUpdateResult")
+	proto.RegisterType((*CommandGetCatalogs)(nil), "arrow.flight.protocol.sql.CommandGetCatalogs")
+	proto.RegisterType((*CommandGetSchemas)(nil), "arrow.flight.protocol.sql.CommandGetSchemas")
+	proto.RegisterType((*CommandGetTables)(nil), "arrow.flight.protocol.sql.CommandGetTables")
+	proto.RegisterType((*CommandGetPrimaryKeys)(nil), "arrow.flight.protocol.sql.CommandGetPrimaryKeys")
+	proto.RegisterType((*ActionBeginTransactionRequest)(nil), "arrow.flight.protocol.sql.ActionBeginTransactionRequest")
+	proto.RegisterType((*ActionBeginTransactionResult)(nil), "arrow.flight.protocol.sql.ActionBeginTransactionResult")
+	proto.RegisterType((*ActionEndTransactionRequest)(nil), "arrow.flight.protocol.sql.ActionEndTransactionRequest")
+}
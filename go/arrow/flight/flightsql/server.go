@@ -0,0 +1,353 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flightsql
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	"github.com/apache/arrow/go/arrow/flight"
+	sqlpb "github.com/apache/arrow/go/arrow/flight/flightsql/internal/flightsql"
+	"github.com/apache/arrow/go/arrow/internal/arrio"
+	proto "github.com/golang/protobuf/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Server is implemented by a SQL engine's Flight SQL driver. It describes
+// the database in terms a little higher-level than raw Flight RPCs: a
+// driver answers "run this query" or "list these tables", and Server
+// (via NewServer) takes care of encoding that as GetFlightInfo/DoGet/
+// DoAction calls that any Flight client understands.
+//
+// Embed BaseServer to get Unimplemented behavior for any method a given
+// driver doesn't support.
+type Server interface {
+	// GetFlightInfoStatement returns the FlightInfo for executing query,
+	// described by desc.
+	GetFlightInfoStatement(ctx context.Context, query *sqlpb.CommandStatementQuery, desc *flight.FlightDescriptor) (*flight.FlightInfo, error)
+	// DoGetStatement streams the results of the query encoded by ticket.
+	DoGetStatement(ctx context.Context, ticket *sqlpb.TicketStatementQuery) (*arrow.Schema, arrio.Reader, error)
+	// DoPutStatementUpdate executes an update statement and returns the
+	// number of rows it affected.
+	DoPutStatementUpdate(ctx context.Context, cmd *sqlpb.CommandStatementUpdate) (int64, error)
+
+	// CreatePreparedStatement prepares query for repeated execution.
+	CreatePreparedStatement(ctx context.Context, req *sqlpb.ActionCreatePreparedStatementRequest) (*sqlpb.ActionCreatePreparedStatementResult, error)
+	// ClosePreparedStatement releases a prepared statement's resources.
+	ClosePreparedStatement(ctx context.Context, req *sqlpb.ActionClosePreparedStatementRequest) error
+	// GetFlightInfoPreparedStatement returns the FlightInfo for executing
+	// a previously prepared statement.
+	GetFlightInfoPreparedStatement(ctx context.Context, cmd *sqlpb.CommandPreparedStatementQuery, desc *flight.FlightDescriptor) (*flight.FlightInfo, error)
+	// DoGetPreparedStatement streams the results of a prepared statement.
+	DoGetPreparedStatement(ctx context.Context, cmd *sqlpb.CommandPreparedStatementQuery) (*arrow.Schema, arrio.Reader, error)
+
+	// GetCatalogs lists the catalogs known to the database.
+	GetCatalogs(ctx context.Context) (*arrow.Schema, arrio.Reader, error)
+	// GetSchemas lists the schemas matching cmd's filters.
+	GetSchemas(ctx context.Context, cmd *sqlpb.CommandGetSchemas) (*arrow.Schema, arrio.Reader, error)
+	// GetTables lists the tables matching cmd's filters.
+	GetTables(ctx context.Context, cmd *sqlpb.CommandGetTables) (*arrow.Schema, arrio.Reader, error)
+	// GetPrimaryKeys lists the primary key columns of cmd.Table.
+	GetPrimaryKeys(ctx context.Context, cmd *sqlpb.CommandGetPrimaryKeys) (*arrow.Schema, arrio.Reader, error)
+
+	// BeginTransaction starts a new transaction and returns its opaque
+	// identifier.
+	BeginTransaction(ctx context.Context) ([]byte, error)
+	// EndTransaction commits or rolls back the transaction identified by
+	// transactionID, according to action.
+	EndTransaction(ctx context.Context, transactionID []byte, action sqlpb.TransactionEndAction) error
+}
+
+// BaseServer answers every Server method with codes.Unimplemented, so that
+// drivers only need to override the methods they actually support.
+type BaseServer struct{}
+
+func (BaseServer) GetFlightInfoStatement(context.Context, *sqlpb.CommandStatementQuery, *flight.FlightDescriptor) (*flight.FlightInfo, error) {
+	return nil, status.Error(codes.Unimplemented, "GetFlightInfoStatement not implemented")
+}
+
+func (BaseServer) DoGetStatement(context.Context, *sqlpb.TicketStatementQuery) (*arrow.Schema, arrio.Reader, error) {
+	return nil, nil, status.Error(codes.Unimplemented, "DoGetStatement not implemented")
+}
+
+func (BaseServer) DoPutStatementUpdate(context.Context, *sqlpb.CommandStatementUpdate) (int64, error) {
+	return 0, status.Error(codes.Unimplemented, "DoPutStatementUpdate not implemented")
+}
+
+func (BaseServer) CreatePreparedStatement(context.Context, *sqlpb.ActionCreatePreparedStatementRequest) (*sqlpb.ActionCreatePreparedStatementResult, error) {
+	return nil, status.Error(codes.Unimplemented, "CreatePreparedStatement not implemented")
+}
+
+func (BaseServer) ClosePreparedStatement(context.Context, *sqlpb.ActionClosePreparedStatementRequest) error {
+	return status.Error(codes.Unimplemented, "ClosePreparedStatement not implemented")
+}
+
+func (BaseServer) GetFlightInfoPreparedStatement(context.Context, *sqlpb.CommandPreparedStatementQuery, *flight.FlightDescriptor) (*flight.FlightInfo, error) {
+	return nil, status.Error(codes.Unimplemented, "GetFlightInfoPreparedStatement not implemented")
+}
+
+func (BaseServer) DoGetPreparedStatement(context.Context, *sqlpb.CommandPreparedStatementQuery) (*arrow.Schema, arrio.Reader, error) {
+	return nil, nil, status.Error(codes.Unimplemented, "DoGetPreparedStatement not implemented")
+}
+
+func (BaseServer) GetCatalogs(context.Context) (*arrow.Schema, arrio.Reader, error) {
+	return nil, nil, status.Error(codes.Unimplemented, "GetCatalogs not implemented")
+}
+
+func (BaseServer) GetSchemas(context.Context, *sqlpb.CommandGetSchemas) (*arrow.Schema, arrio.Reader, error) {
+	return nil, nil, status.Error(codes.Unimplemented, "GetSchemas not implemented")
+}
+
+func (BaseServer) GetTables(context.Context, *sqlpb.CommandGetTables) (*arrow.Schema, arrio.Reader, error) {
+	return nil, nil, status.Error(codes.Unimplemented, "GetTables not implemented")
+}
+
+func (BaseServer) GetPrimaryKeys(context.Context, *sqlpb.CommandGetPrimaryKeys) (*arrow.Schema, arrio.Reader, error) {
+	return nil, nil, status.Error(codes.Unimplemented, "GetPrimaryKeys not implemented")
+}
+
+func (BaseServer) BeginTransaction(context.Context) ([]byte, error) {
+	return nil, status.Error(codes.Unimplemented, "BeginTransaction not implemented")
+}
+
+func (BaseServer) EndTransaction(context.Context, []byte, sqlpb.TransactionEndAction) error {
+	return status.Error(codes.Unimplemented, "EndTransaction not implemented")
+}
+
+var _ Server = (*BaseServer)(nil)
+
+// NewServer adapts svc to a flight.Server, translating the Flight SQL
+// commands packed into FlightDescriptor/Ticket into calls against svc.
+func NewServer(svc Server, opts ...grpc.ServerOption) *flight.Server {
+	return flight.NewServer(&flightService{svc: svc}, opts...)
+}
+
+// flightService implements flight.FlightService by dispatching on the
+// Flight SQL command packed into each request.
+type flightService struct {
+	flight.BaseFlightService
+	svc Server
+}
+
+func (s *flightService) GetFlightInfo(ctx context.Context, desc *flight.FlightDescriptor) (*flight.FlightInfo, error) {
+	name, err := commandName(desc.GetCmd())
+	if err != nil {
+		return nil, err
+	}
+	switch name {
+	case "CommandStatementQuery":
+		var cmd sqlpb.CommandStatementQuery
+		if err := unpackCommand(desc.GetCmd(), name, &cmd); err != nil {
+			return nil, err
+		}
+		return s.svc.GetFlightInfoStatement(ctx, &cmd, desc)
+	case "CommandPreparedStatementQuery":
+		var cmd sqlpb.CommandPreparedStatementQuery
+		if err := unpackCommand(desc.GetCmd(), name, &cmd); err != nil {
+			return nil, err
+		}
+		return s.svc.GetFlightInfoPreparedStatement(ctx, &cmd, desc)
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "flightsql: unsupported command %s for GetFlightInfo", name)
+	}
+}
+
+func (s *flightService) DoGet(ticket *flight.Ticket, stream flight.DoGetServer) error {
+	ctx := stream.Context()
+	name, err := commandName(ticket.GetTicket())
+	if err != nil {
+		return err
+	}
+
+	var (
+		schema *arrow.Schema
+		rdr    arrio.Reader
+	)
+	switch name {
+	case "TicketStatementQuery":
+		var cmd sqlpb.TicketStatementQuery
+		if err := unpackCommand(ticket.GetTicket(), name, &cmd); err != nil {
+			return err
+		}
+		schema, rdr, err = s.svc.DoGetStatement(ctx, &cmd)
+	case "CommandPreparedStatementQuery":
+		var cmd sqlpb.CommandPreparedStatementQuery
+		if err := unpackCommand(ticket.GetTicket(), name, &cmd); err != nil {
+			return err
+		}
+		schema, rdr, err = s.svc.DoGetPreparedStatement(ctx, &cmd)
+	case "CommandGetCatalogs":
+		schema, rdr, err = s.svc.GetCatalogs(ctx)
+	case "CommandGetSchemas":
+		var cmd sqlpb.CommandGetSchemas
+		if err := unpackCommand(ticket.GetTicket(), name, &cmd); err != nil {
+			return err
+		}
+		schema, rdr, err = s.svc.GetSchemas(ctx, &cmd)
+	case "CommandGetTables":
+		var cmd sqlpb.CommandGetTables
+		if err := unpackCommand(ticket.GetTicket(), name, &cmd); err != nil {
+			return err
+		}
+		schema, rdr, err = s.svc.GetTables(ctx, &cmd)
+	case "CommandGetPrimaryKeys":
+		var cmd sqlpb.CommandGetPrimaryKeys
+		if err := unpackCommand(ticket.GetTicket(), name, &cmd); err != nil {
+			return err
+		}
+		schema, rdr, err = s.svc.GetPrimaryKeys(ctx, &cmd)
+	default:
+		return status.Errorf(codes.InvalidArgument, "flightsql: unsupported command %s for DoGet", name)
+	}
+	if err != nil {
+		return err
+	}
+
+	w, err := flight.RecordBatchWriter(stream, schema)
+	if err != nil {
+		return err
+	}
+	for {
+		rec, err := rdr.Read()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+		err = w.Write(rec)
+		rec.Release()
+		if err != nil {
+			return err
+		}
+	}
+	return w.Close()
+}
+
+func (s *flightService) DoAction(action *flight.Action, stream flight.DoActionServer) error {
+	ctx := stream.Context()
+	switch action.GetType() {
+	case "CreatePreparedStatement":
+		var req sqlpb.ActionCreatePreparedStatementRequest
+		if err := proto.Unmarshal(action.GetBody(), &req); err != nil {
+			return err
+		}
+		res, err := s.svc.CreatePreparedStatement(ctx, &req)
+		if err != nil {
+			return err
+		}
+		body, err := proto.Marshal(res)
+		if err != nil {
+			return err
+		}
+		return stream.Send(&flight.Result{Body: body})
+	case "ClosePreparedStatement":
+		var req sqlpb.ActionClosePreparedStatementRequest
+		if err := proto.Unmarshal(action.GetBody(), &req); err != nil {
+			return err
+		}
+		return s.svc.ClosePreparedStatement(ctx, &req)
+	case "BeginTransaction":
+		id, err := s.svc.BeginTransaction(ctx)
+		if err != nil {
+			return err
+		}
+		body, err := proto.Marshal(&sqlpb.ActionBeginTransactionResult{TransactionId: id})
+		if err != nil {
+			return err
+		}
+		return stream.Send(&flight.Result{Body: body})
+	case "EndTransaction":
+		var req sqlpb.ActionEndTransactionRequest
+		if err := proto.Unmarshal(action.GetBody(), &req); err != nil {
+			return err
+		}
+		return s.svc.EndTransaction(ctx, req.GetTransactionId(), req.GetAction())
+	default:
+		return status.Errorf(codes.InvalidArgument, "flightsql: unsupported action %s", action.GetType())
+	}
+}
+
+func (s *flightService) DoPut(stream flight.DoPutServer) error {
+	rdr, err := flight.RecordBatchReader(stream, nil)
+	if err != nil {
+		return err
+	}
+	// DoPut is only used for CommandStatementUpdate in this package; the
+	// command travels on the first FlightData's FlightDescriptor and the
+	// record batches (if any) describe the update's parameters.
+	data, err := stream.Recv()
+	if err != nil {
+		return fmt.Errorf("flightsql: DoPut requires a FlightDescriptor: %w", err)
+	}
+	name, err := commandName(data.GetFlightDescriptor().GetCmd())
+	if err != nil {
+		return err
+	}
+	if name != "CommandStatementUpdate" {
+		return status.Errorf(codes.InvalidArgument, "flightsql: unsupported command %s for DoPut", name)
+	}
+	var cmd sqlpb.CommandStatementUpdate
+	if err := unpackCommand(data.GetFlightDescriptor().GetCmd(), name, &cmd); err != nil {
+		return err
+	}
+	for {
+		if _, err := rdr.Read(); err == io.EOF {
+			break
+		} else if err != nil {
+			return err
+		}
+	}
+	n, err := s.svc.DoPutStatementUpdate(stream.Context(), &cmd)
+	if err != nil {
+		return err
+	}
+	body, err := proto.Marshal(&sqlpb.DoPutUpdateResult{RecordCount: n})
+	if err != nil {
+		return err
+	}
+	return stream.Send(&flight.PutResult{AppMetadata: body})
+}
+
+// sliceReader is an arrio.Reader over an in-memory slice of records, for
+// drivers (like the in-memory example backend) that materialize an entire
+// result set up front rather than streaming it lazily.
+type sliceReader struct {
+	recs []array.Record
+	pos  int
+}
+
+// NewSliceReader returns an arrio.Reader that yields each record in recs in
+// order, then io.EOF. The reader takes over one reference to each record in
+// recs and hands it to the caller of Read, who must release it.
+func NewSliceReader(recs []array.Record) arrio.Reader {
+	return &sliceReader{recs: recs}
+}
+
+func (r *sliceReader) Read() (array.Record, error) {
+	if r.pos >= len(r.recs) {
+		return nil, io.EOF
+	}
+	rec := r.recs[r.pos]
+	r.pos++
+	return rec, nil
+}
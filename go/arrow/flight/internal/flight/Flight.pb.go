@@ -0,0 +1,408 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Hand-written stand-in for protoc-gen-go output generated from Flight.proto.
+//
+// This is not the literal output of protoc-gen-go. Regenerating it for real
+// needs both `protoc` and the `protoc-gen-go` plugin at the pinned
+// google.golang.org/protobuf v1.25.0, and neither is available in this
+// environment: there's no `go` toolchain to `go install` the plugin (or run
+// `go generate`) and no network access to fetch a protoc release or package
+// (`apt-get install protobuf-compiler` fails to resolve deb.debian.org). The
+// message types below instead use the pre-APIv2 shape (Reset/String/
+// ProtoMessage plus `protobuf:` struct tags, registered with
+// proto.RegisterType), which github.com/golang/protobuf v1.4.2's
+// legacy-message compatibility layer still marshals and unmarshals correctly
+// against the pinned google.golang.org/protobuf v1.25.0 - it is not what
+// protoc-gen-go itself would emit at these versions (which generates
+// ProtoReflect() and a protoimpl.TypeBuilder keyed off a serialized
+// FileDescriptorProto). Once protoc and protoc-gen-go v1.25.0 are available,
+// run protoc-gen-go against Flight.proto and replace this file wholesale
+// rather than hand-editing it further.
+
+package flight
+
+import (
+	proto "github.com/golang/protobuf/proto"
+)
+
+type FlightDescriptor_DescriptorType int32
+
+const (
+	FlightDescriptor_UNKNOWN FlightDescriptor_DescriptorType = 0
+	FlightDescriptor_PATH    FlightDescriptor_DescriptorType = 1
+	FlightDescriptor_CMD     FlightDescriptor_DescriptorType = 2
+)
+
+var FlightDescriptor_DescriptorType_name = map[int32]string{
+	0: "UNKNOWN",
+	1: "PATH",
+	2: "CMD",
+}
+
+func (x FlightDescriptor_DescriptorType) String() string {
+	return FlightDescriptor_DescriptorType_name[int32(x)]
+}
+
+type HandshakeRequest struct {
+	ProtocolVersion uint64 `protobuf:"varint,1,opt,name=protocol_version,json=protocolVersion,proto3" json:"protocol_version,omitempty"`
+	Payload         []byte `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+}
+
+func (m *HandshakeRequest) Reset()         { *m = HandshakeRequest{} }
+func (m *HandshakeRequest) String() string { return proto.CompactTextString(m) }
+func (*HandshakeRequest) ProtoMessage()    {}
+
+func (m *HandshakeRequest) GetProtocolVersion() uint64 {
+	if m != nil {
+		return m.ProtocolVersion
+	}
+	return 0
+}
+
+func (m *HandshakeRequest) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+type HandshakeResponse struct {
+	ProtocolVersion uint64 `protobuf:"varint,1,opt,name=protocol_version,json=protocolVersion,proto3" json:"protocol_version,omitempty"`
+	Payload         []byte `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+}
+
+func (m *HandshakeResponse) Reset()         { *m = HandshakeResponse{} }
+func (m *HandshakeResponse) String() string { return proto.CompactTextString(m) }
+func (*HandshakeResponse) ProtoMessage()    {}
+
+func (m *HandshakeResponse) GetProtocolVersion() uint64 {
+	if m != nil {
+		return m.ProtocolVersion
+	}
+	return 0
+}
+
+func (m *HandshakeResponse) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+type BasicAuth struct {
+	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Password string `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+}
+
+func (m *BasicAuth) Reset()         { *m = BasicAuth{} }
+func (m *BasicAuth) String() string { return proto.CompactTextString(m) }
+func (*BasicAuth) ProtoMessage()    {}
+
+type Empty struct{}
+
+func (m *Empty) Reset()         { *m = Empty{} }
+func (m *Empty) String() string { return proto.CompactTextString(m) }
+func (*Empty) ProtoMessage()    {}
+
+type ActionType struct {
+	Type        string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+}
+
+func (m *ActionType) Reset()         { *m = ActionType{} }
+func (m *ActionType) String() string { return proto.CompactTextString(m) }
+func (*ActionType) ProtoMessage()    {}
+
+func (m *ActionType) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *ActionType) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+type Criteria struct {
+	Expression []byte `protobuf:"bytes,1,opt,name=expression,proto3" json:"expression,omitempty"`
+}
+
+func (m *Criteria) Reset()         { *m = Criteria{} }
+func (m *Criteria) String() string { return proto.CompactTextString(m) }
+func (*Criteria) ProtoMessage()    {}
+
+type Action struct {
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Body []byte `protobuf:"bytes,2,opt,name=body,proto3" json:"body,omitempty"`
+}
+
+func (m *Action) Reset()         { *m = Action{} }
+func (m *Action) String() string { return proto.CompactTextString(m) }
+func (*Action) ProtoMessage()    {}
+
+func (m *Action) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *Action) GetBody() []byte {
+	if m != nil {
+		return m.Body
+	}
+	return nil
+}
+
+type Result struct {
+	Body []byte `protobuf:"bytes,1,opt,name=body,proto3" json:"body,omitempty"`
+}
+
+func (m *Result) Reset()         { *m = Result{} }
+func (m *Result) String() string { return proto.CompactTextString(m) }
+func (*Result) ProtoMessage()    {}
+
+func (m *Result) GetBody() []byte {
+	if m != nil {
+		return m.Body
+	}
+	return nil
+}
+
+type SchemaResult struct {
+	Schema []byte `protobuf:"bytes,1,opt,name=schema,proto3" json:"schema,omitempty"`
+}
+
+func (m *SchemaResult) Reset()         { *m = SchemaResult{} }
+func (m *SchemaResult) String() string { return proto.CompactTextString(m) }
+func (*SchemaResult) ProtoMessage()    {}
+
+func (m *SchemaResult) GetSchema() []byte {
+	if m != nil {
+		return m.Schema
+	}
+	return nil
+}
+
+type FlightDescriptor struct {
+	Type FlightDescriptor_DescriptorType `protobuf:"varint,1,opt,name=type,proto3,enum=arrow.flight.protocol.FlightDescriptor_DescriptorType" json:"type,omitempty"`
+	Cmd  []byte                          `protobuf:"bytes,2,opt,name=cmd,proto3" json:"cmd,omitempty"`
+	Path []string                        `protobuf:"bytes,3,rep,name=path,proto3" json:"path,omitempty"`
+}
+
+func (m *FlightDescriptor) Reset()         { *m = FlightDescriptor{} }
+func (m *FlightDescriptor) String() string { return proto.CompactTextString(m) }
+func (*FlightDescriptor) ProtoMessage()    {}
+
+func (m *FlightDescriptor) GetType() FlightDescriptor_DescriptorType {
+	if m != nil {
+		return m.Type
+	}
+	return FlightDescriptor_UNKNOWN
+}
+
+func (m *FlightDescriptor) GetCmd() []byte {
+	if m != nil {
+		return m.Cmd
+	}
+	return nil
+}
+
+func (m *FlightDescriptor) GetPath() []string {
+	if m != nil {
+		return m.Path
+	}
+	return nil
+}
+
+type FlightInfo struct {
+	Schema           []byte            `protobuf:"bytes,1,opt,name=schema,proto3" json:"schema,omitempty"`
+	FlightDescriptor *FlightDescriptor `protobuf:"bytes,2,opt,name=flight_descriptor,json=flightDescriptor,proto3" json:"flight_descriptor,omitempty"`
+	Endpoint         []*FlightEndpoint `protobuf:"bytes,3,rep,name=endpoint,proto3" json:"endpoint,omitempty"`
+	TotalRecords     int64             `protobuf:"varint,4,opt,name=total_records,json=totalRecords,proto3" json:"total_records,omitempty"`
+	TotalBytes       int64             `protobuf:"varint,5,opt,name=total_bytes,json=totalBytes,proto3" json:"total_bytes,omitempty"`
+}
+
+func (m *FlightInfo) Reset()         { *m = FlightInfo{} }
+func (m *FlightInfo) String() string { return proto.CompactTextString(m) }
+func (*FlightInfo) ProtoMessage()    {}
+
+func (m *FlightInfo) GetSchema() []byte {
+	if m != nil {
+		return m.Schema
+	}
+	return nil
+}
+
+func (m *FlightInfo) GetFlightDescriptor() *FlightDescriptor {
+	if m != nil {
+		return m.FlightDescriptor
+	}
+	return nil
+}
+
+func (m *FlightInfo) GetEndpoint() []*FlightEndpoint {
+	if m != nil {
+		return m.Endpoint
+	}
+	return nil
+}
+
+func (m *FlightInfo) GetTotalRecords() int64 {
+	if m != nil {
+		return m.TotalRecords
+	}
+	return 0
+}
+
+func (m *FlightInfo) GetTotalBytes() int64 {
+	if m != nil {
+		return m.TotalBytes
+	}
+	return 0
+}
+
+type FlightEndpoint struct {
+	Ticket   *Ticket     `protobuf:"bytes,1,opt,name=ticket,proto3" json:"ticket,omitempty"`
+	Location []*Location `protobuf:"bytes,2,rep,name=location,proto3" json:"location,omitempty"`
+}
+
+func (m *FlightEndpoint) Reset()         { *m = FlightEndpoint{} }
+func (m *FlightEndpoint) String() string { return proto.CompactTextString(m) }
+func (*FlightEndpoint) ProtoMessage()    {}
+
+func (m *FlightEndpoint) GetTicket() *Ticket {
+	if m != nil {
+		return m.Ticket
+	}
+	return nil
+}
+
+func (m *FlightEndpoint) GetLocation() []*Location {
+	if m != nil {
+		return m.Location
+	}
+	return nil
+}
+
+type Location struct {
+	Uri string `protobuf:"bytes,1,opt,name=uri,proto3" json:"uri,omitempty"`
+}
+
+func (m *Location) Reset()         { *m = Location{} }
+func (m *Location) String() string { return proto.CompactTextString(m) }
+func (*Location) ProtoMessage()    {}
+
+func (m *Location) GetUri() string {
+	if m != nil {
+		return m.Uri
+	}
+	return ""
+}
+
+type Ticket struct {
+	Ticket []byte `protobuf:"bytes,1,opt,name=ticket,proto3" json:"ticket,omitempty"`
+}
+
+func (m *Ticket) Reset()         { *m = Ticket{} }
+func (m *Ticket) String() string { return proto.CompactTextString(m) }
+func (*Ticket) ProtoMessage()    {}
+
+func (m *Ticket) GetTicket() []byte {
+	if m != nil {
+		return m.Ticket
+	}
+	return nil
+}
+
+type FlightData struct {
+	FlightDescriptor *FlightDescriptor `protobuf:"bytes,1,opt,name=flight_descriptor,json=flightDescriptor,proto3" json:"flight_descriptor,omitempty"`
+	DataHeader       []byte            `protobuf:"bytes,2,opt,name=data_header,json=dataHeader,proto3" json:"data_header,omitempty"`
+	AppMetadata      []byte            `protobuf:"bytes,3,opt,name=app_metadata,json=appMetadata,proto3" json:"app_metadata,omitempty"`
+	DataBody         []byte            `protobuf:"bytes,1000,opt,name=data_body,json=dataBody,proto3" json:"data_body,omitempty"`
+}
+
+func (m *FlightData) Reset()         { *m = FlightData{} }
+func (m *FlightData) String() string { return proto.CompactTextString(m) }
+func (*FlightData) ProtoMessage()    {}
+
+func (m *FlightData) GetFlightDescriptor() *FlightDescriptor {
+	if m != nil {
+		return m.FlightDescriptor
+	}
+	return nil
+}
+
+func (m *FlightData) GetDataHeader() []byte {
+	if m != nil {
+		return m.DataHeader
+	}
+	return nil
+}
+
+func (m *FlightData) GetAppMetadata() []byte {
+	if m != nil {
+		return m.AppMetadata
+	}
+	return nil
+}
+
+func (m *FlightData) GetDataBody() []byte {
+	if m != nil {
+		return m.DataBody
+	}
+	return nil
+}
+
+type PutResult struct {
+	AppMetadata []byte `protobuf:"bytes,1,opt,name=app_metadata,json=appMetadata,proto3" json:"app_metadata,omitempty"`
+}
+
+func (m *PutResult) Reset()         { *m = PutResult{} }
+func (m *PutResult) String() string { return proto.CompactTextString(m) }
+func (*PutResult) ProtoMessage()    {}
+
+func (m *PutResult) GetAppMetadata() []byte {
+	if m != nil {
+		return m.AppMetadata
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterEnum("arrow.flight.protocol.FlightDescriptor_DescriptorType", FlightDescriptor_DescriptorType_name, nil)
+	proto.RegisterType((*HandshakeRequest)(nil), "arrow.flight.protocol.HandshakeRequest")
+	proto.RegisterType((*HandshakeResponse)(nil), "arrow.flight.protocol.HandshakeResponse")
+	proto.RegisterType((*BasicAuth)(nil), "arrow.flight.protocol.BasicAuth")
+	proto.RegisterType((*Empty)(nil), "arrow.flight.protocol.Empty")
+	proto.RegisterType((*ActionType)(nil), "arrow.flight.protocol.ActionType")
+	proto.RegisterType((*Criteria)(nil), "arrow.flight.protocol.Criteria")
+	proto.RegisterType((*Action)(nil), "arrow.flight.protocol.Action")
+	proto.RegisterType((*Result)(nil), "arrow.flight.protocol.Result")
+	proto.RegisterType((*SchemaResult)(nil), "arrow.flight.protocol.SchemaResult")
+	proto.RegisterType((*FlightDescriptor)(nil), "arrow.flight.protocol.FlightDescriptor")
+	proto.RegisterType((*FlightInfo)(nil), "arrow.flight.protocol.FlightInfo")
+	proto.RegisterType((*FlightEndpoint)(nil), "arrow.flight.protocol.FlightEndpoint")
+	proto.RegisterType((*Location)(nil), "arrow.flight.protocol.Location")
+	proto.RegisterType((*Ticket)(nil), "arrow.flight.protocol.Ticket")
+	proto.RegisterType((*FlightData)(nil), "arrow.flight.protocol.FlightData")
+	proto.RegisterType((*PutResult)(nil), "arrow.flight.protocol.PutResult")
+}
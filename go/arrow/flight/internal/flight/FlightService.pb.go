@@ -0,0 +1,596 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Hand-written stand-in for protoc-gen-go-grpc output generated from
+// Flight.proto; see Flight.pb.go for why this isn't the literal output of
+// a protoc run. The client/server stubs below only depend on grpc.ClientStream
+// /ServerStream's SendMsg/RecvMsg, which work against any message satisfying
+// Flight.pb.go's proto.Message implementations regardless of which protoc-gen-go
+// generation shape produced them, so this part doesn't have the same
+// compatibility concern as the message types do.
+
+package flight
+
+import (
+	context "context"
+
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// FlightServiceClient is the client API for FlightService service.
+type FlightServiceClient interface {
+	Handshake(ctx context.Context, opts ...grpc.CallOption) (FlightService_HandshakeClient, error)
+	ListFlights(ctx context.Context, in *Criteria, opts ...grpc.CallOption) (FlightService_ListFlightsClient, error)
+	GetFlightInfo(ctx context.Context, in *FlightDescriptor, opts ...grpc.CallOption) (*FlightInfo, error)
+	GetSchema(ctx context.Context, in *FlightDescriptor, opts ...grpc.CallOption) (*SchemaResult, error)
+	DoGet(ctx context.Context, in *Ticket, opts ...grpc.CallOption) (FlightService_DoGetClient, error)
+	DoPut(ctx context.Context, opts ...grpc.CallOption) (FlightService_DoPutClient, error)
+	DoExchange(ctx context.Context, opts ...grpc.CallOption) (FlightService_DoExchangeClient, error)
+	DoAction(ctx context.Context, in *Action, opts ...grpc.CallOption) (FlightService_DoActionClient, error)
+	ListActions(ctx context.Context, in *Empty, opts ...grpc.CallOption) (FlightService_ListActionsClient, error)
+}
+
+type flightServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+// NewFlightServiceClient constructs a client stub for the FlightService
+// service, per the generated pattern produced by protoc-gen-go-grpc.
+func NewFlightServiceClient(cc grpc.ClientConnInterface) FlightServiceClient {
+	return &flightServiceClient{cc}
+}
+
+func (c *flightServiceClient) Handshake(ctx context.Context, opts ...grpc.CallOption) (FlightService_HandshakeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_FlightService_serviceDesc.Streams[0], "/arrow.flight.protocol.FlightService/Handshake", opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &flightServiceHandshakeClient{stream}, nil
+}
+
+type FlightService_HandshakeClient interface {
+	Send(*HandshakeRequest) error
+	Recv() (*HandshakeResponse, error)
+	grpc.ClientStream
+}
+
+type flightServiceHandshakeClient struct {
+	grpc.ClientStream
+}
+
+func (x *flightServiceHandshakeClient) Send(m *HandshakeRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *flightServiceHandshakeClient) Recv() (*HandshakeResponse, error) {
+	m := new(HandshakeResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *flightServiceClient) ListFlights(ctx context.Context, in *Criteria, opts ...grpc.CallOption) (FlightService_ListFlightsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_FlightService_serviceDesc.Streams[1], "/arrow.flight.protocol.FlightService/ListFlights", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &flightServiceListFlightsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type FlightService_ListFlightsClient interface {
+	Recv() (*FlightInfo, error)
+	grpc.ClientStream
+}
+
+type flightServiceListFlightsClient struct {
+	grpc.ClientStream
+}
+
+func (x *flightServiceListFlightsClient) Recv() (*FlightInfo, error) {
+	m := new(FlightInfo)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *flightServiceClient) GetFlightInfo(ctx context.Context, in *FlightDescriptor, opts ...grpc.CallOption) (*FlightInfo, error) {
+	out := new(FlightInfo)
+	if err := c.cc.Invoke(ctx, "/arrow.flight.protocol.FlightService/GetFlightInfo", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *flightServiceClient) GetSchema(ctx context.Context, in *FlightDescriptor, opts ...grpc.CallOption) (*SchemaResult, error) {
+	out := new(SchemaResult)
+	if err := c.cc.Invoke(ctx, "/arrow.flight.protocol.FlightService/GetSchema", in, out, opts...); err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *flightServiceClient) DoGet(ctx context.Context, in *Ticket, opts ...grpc.CallOption) (FlightService_DoGetClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_FlightService_serviceDesc.Streams[2], "/arrow.flight.protocol.FlightService/DoGet", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &flightServiceDoGetClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type FlightService_DoGetClient interface {
+	Recv() (*FlightData, error)
+	grpc.ClientStream
+}
+
+type flightServiceDoGetClient struct {
+	grpc.ClientStream
+}
+
+func (x *flightServiceDoGetClient) Recv() (*FlightData, error) {
+	m := new(FlightData)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *flightServiceClient) DoPut(ctx context.Context, opts ...grpc.CallOption) (FlightService_DoPutClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_FlightService_serviceDesc.Streams[3], "/arrow.flight.protocol.FlightService/DoPut", opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &flightServiceDoPutClient{stream}, nil
+}
+
+type FlightService_DoPutClient interface {
+	Send(*FlightData) error
+	Recv() (*PutResult, error)
+	grpc.ClientStream
+}
+
+type flightServiceDoPutClient struct {
+	grpc.ClientStream
+}
+
+func (x *flightServiceDoPutClient) Send(m *FlightData) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *flightServiceDoPutClient) Recv() (*PutResult, error) {
+	m := new(PutResult)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *flightServiceClient) DoExchange(ctx context.Context, opts ...grpc.CallOption) (FlightService_DoExchangeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_FlightService_serviceDesc.Streams[4], "/arrow.flight.protocol.FlightService/DoExchange", opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &flightServiceDoExchangeClient{stream}, nil
+}
+
+type FlightService_DoExchangeClient interface {
+	Send(*FlightData) error
+	Recv() (*FlightData, error)
+	grpc.ClientStream
+}
+
+type flightServiceDoExchangeClient struct {
+	grpc.ClientStream
+}
+
+func (x *flightServiceDoExchangeClient) Send(m *FlightData) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *flightServiceDoExchangeClient) Recv() (*FlightData, error) {
+	m := new(FlightData)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *flightServiceClient) DoAction(ctx context.Context, in *Action, opts ...grpc.CallOption) (FlightService_DoActionClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_FlightService_serviceDesc.Streams[5], "/arrow.flight.protocol.FlightService/DoAction", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &flightServiceDoActionClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type FlightService_DoActionClient interface {
+	Recv() (*Result, error)
+	grpc.ClientStream
+}
+
+type flightServiceDoActionClient struct {
+	grpc.ClientStream
+}
+
+func (x *flightServiceDoActionClient) Recv() (*Result, error) {
+	m := new(Result)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *flightServiceClient) ListActions(ctx context.Context, in *Empty, opts ...grpc.CallOption) (FlightService_ListActionsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_FlightService_serviceDesc.Streams[6], "/arrow.flight.protocol.FlightService/ListActions", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &flightServiceListActionsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type FlightService_ListActionsClient interface {
+	Recv() (*ActionType, error)
+	grpc.ClientStream
+}
+
+type flightServiceListActionsClient struct {
+	grpc.ClientStream
+}
+
+func (x *flightServiceListActionsClient) Recv() (*ActionType, error) {
+	m := new(ActionType)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// FlightServiceServer is the server API for FlightService service.
+type FlightServiceServer interface {
+	Handshake(FlightService_HandshakeServer) error
+	ListFlights(*Criteria, FlightService_ListFlightsServer) error
+	GetFlightInfo(context.Context, *FlightDescriptor) (*FlightInfo, error)
+	GetSchema(context.Context, *FlightDescriptor) (*SchemaResult, error)
+	DoGet(*Ticket, FlightService_DoGetServer) error
+	DoPut(FlightService_DoPutServer) error
+	DoExchange(FlightService_DoExchangeServer) error
+	DoAction(*Action, FlightService_DoActionServer) error
+	ListActions(*Empty, FlightService_ListActionsServer) error
+}
+
+// UnimplementedFlightServiceServer can be embedded to have forward
+// compatible implementations that return Unimplemented for any method
+// not explicitly overridden.
+type UnimplementedFlightServiceServer struct{}
+
+func (*UnimplementedFlightServiceServer) Handshake(FlightService_HandshakeServer) error {
+	return status.Errorf(codes.Unimplemented, "method Handshake not implemented")
+}
+func (*UnimplementedFlightServiceServer) ListFlights(*Criteria, FlightService_ListFlightsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListFlights not implemented")
+}
+func (*UnimplementedFlightServiceServer) GetFlightInfo(context.Context, *FlightDescriptor) (*FlightInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFlightInfo not implemented")
+}
+func (*UnimplementedFlightServiceServer) GetSchema(context.Context, *FlightDescriptor) (*SchemaResult, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSchema not implemented")
+}
+func (*UnimplementedFlightServiceServer) DoGet(*Ticket, FlightService_DoGetServer) error {
+	return status.Errorf(codes.Unimplemented, "method DoGet not implemented")
+}
+func (*UnimplementedFlightServiceServer) DoPut(FlightService_DoPutServer) error {
+	return status.Errorf(codes.Unimplemented, "method DoPut not implemented")
+}
+func (*UnimplementedFlightServiceServer) DoExchange(FlightService_DoExchangeServer) error {
+	return status.Errorf(codes.Unimplemented, "method DoExchange not implemented")
+}
+func (*UnimplementedFlightServiceServer) DoAction(*Action, FlightService_DoActionServer) error {
+	return status.Errorf(codes.Unimplemented, "method DoAction not implemented")
+}
+func (*UnimplementedFlightServiceServer) ListActions(*Empty, FlightService_ListActionsServer) error {
+	return status.Errorf(codes.Unimplemented, "method ListActions not implemented")
+}
+
+// RegisterFlightServiceServer registers srv as the implementation backing
+// the FlightService gRPC service descriptor.
+func RegisterFlightServiceServer(s *grpc.Server, srv FlightServiceServer) {
+	s.RegisterService(&_FlightService_serviceDesc, srv)
+}
+
+func _FlightService_Handshake_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(FlightServiceServer).Handshake(&flightServiceHandshakeServer{stream})
+}
+
+type FlightService_HandshakeServer interface {
+	Send(*HandshakeResponse) error
+	Recv() (*HandshakeRequest, error)
+	grpc.ServerStream
+}
+
+type flightServiceHandshakeServer struct {
+	grpc.ServerStream
+}
+
+func (x *flightServiceHandshakeServer) Send(m *HandshakeResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *flightServiceHandshakeServer) Recv() (*HandshakeRequest, error) {
+	m := new(HandshakeRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _FlightService_ListFlights_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(Criteria)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(FlightServiceServer).ListFlights(m, &flightServiceListFlightsServer{stream})
+}
+
+type FlightService_ListFlightsServer interface {
+	Send(*FlightInfo) error
+	grpc.ServerStream
+}
+
+type flightServiceListFlightsServer struct {
+	grpc.ServerStream
+}
+
+func (x *flightServiceListFlightsServer) Send(m *FlightInfo) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _FlightService_GetFlightInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FlightDescriptor)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FlightServiceServer).GetFlightInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/arrow.flight.protocol.FlightService/GetFlightInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FlightServiceServer).GetFlightInfo(ctx, req.(*FlightDescriptor))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FlightService_GetSchema_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FlightDescriptor)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(FlightServiceServer).GetSchema(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/arrow.flight.protocol.FlightService/GetSchema",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(FlightServiceServer).GetSchema(ctx, req.(*FlightDescriptor))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _FlightService_DoGet_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(Ticket)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(FlightServiceServer).DoGet(m, &flightServiceDoGetServer{stream})
+}
+
+type FlightService_DoGetServer interface {
+	Send(*FlightData) error
+	grpc.ServerStream
+}
+
+type flightServiceDoGetServer struct {
+	grpc.ServerStream
+}
+
+func (x *flightServiceDoGetServer) Send(m *FlightData) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _FlightService_DoPut_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(FlightServiceServer).DoPut(&flightServiceDoPutServer{stream})
+}
+
+type FlightService_DoPutServer interface {
+	Send(*PutResult) error
+	Recv() (*FlightData, error)
+	grpc.ServerStream
+}
+
+type flightServiceDoPutServer struct {
+	grpc.ServerStream
+}
+
+func (x *flightServiceDoPutServer) Send(m *PutResult) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *flightServiceDoPutServer) Recv() (*FlightData, error) {
+	m := new(FlightData)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _FlightService_DoExchange_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(FlightServiceServer).DoExchange(&flightServiceDoExchangeServer{stream})
+}
+
+type FlightService_DoExchangeServer interface {
+	Send(*FlightData) error
+	Recv() (*FlightData, error)
+	grpc.ServerStream
+}
+
+type flightServiceDoExchangeServer struct {
+	grpc.ServerStream
+}
+
+func (x *flightServiceDoExchangeServer) Send(m *FlightData) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *flightServiceDoExchangeServer) Recv() (*FlightData, error) {
+	m := new(FlightData)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _FlightService_DoAction_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(Action)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(FlightServiceServer).DoAction(m, &flightServiceDoActionServer{stream})
+}
+
+type FlightService_DoActionServer interface {
+	Send(*Result) error
+	grpc.ServerStream
+}
+
+type flightServiceDoActionServer struct {
+	grpc.ServerStream
+}
+
+func (x *flightServiceDoActionServer) Send(m *Result) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _FlightService_ListActions_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(Empty)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(FlightServiceServer).ListActions(m, &flightServiceListActionsServer{stream})
+}
+
+type FlightService_ListActionsServer interface {
+	Send(*ActionType) error
+	grpc.ServerStream
+}
+
+type flightServiceListActionsServer struct {
+	grpc.ServerStream
+}
+
+func (x *flightServiceListActionsServer) Send(m *ActionType) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _FlightService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "arrow.flight.protocol.FlightService",
+	HandlerType: (*FlightServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetFlightInfo",
+			Handler:    _FlightService_GetFlightInfo_Handler,
+		},
+		{
+			MethodName: "GetSchema",
+			Handler:    _FlightService_GetSchema_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Handshake",
+			Handler:       _FlightService_Handshake_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "ListFlights",
+			Handler:       _FlightService_ListFlights_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "DoGet",
+			Handler:       _FlightService_DoGet_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "DoPut",
+			Handler:       _FlightService_DoPut_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "DoExchange",
+			Handler:       _FlightService_DoExchange_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+		{
+			StreamName:    "DoAction",
+			Handler:       _FlightService_DoAction_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "ListActions",
+			Handler:       _FlightService_ListActions_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "Flight.proto",
+}
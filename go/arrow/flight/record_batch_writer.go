@@ -0,0 +1,106 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flight
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/apache/arrow/go/arrow"
+	"github.com/apache/arrow/go/arrow/array"
+	pb "github.com/apache/arrow/go/arrow/flight/internal/flight"
+	"github.com/apache/arrow/go/arrow/internal/arrio"
+	"github.com/apache/arrow/go/arrow/ipc"
+)
+
+// dataStreamWriter is satisfied by both FlightService_DoPutClient and
+// FlightService_DoExchangeClient.
+type dataStreamWriter interface {
+	Send(*pb.FlightData) error
+}
+
+// flightPayloadWriter implements ipc.PayloadWriter, turning each ipc.Payload
+// produced by an ipc.Writer into one FlightData frame. A Payload keeps its
+// metadata and body as separate fields, so building a frame from
+// payload.Meta() and payload.SerializeBody() never has to guess where one
+// message ends and the next begins, unlike re-splitting a concatenated
+// stream of raw Write calls.
+type flightPayloadWriter struct {
+	stream dataStreamWriter
+
+	// descriptor is only sent on the very first FlightData frame of a
+	// DoPut/DoExchange stream, as required by the protocol.
+	descriptor *pb.FlightDescriptor
+}
+
+func (w *flightPayloadWriter) Start() error { return nil }
+
+func (w *flightPayloadWriter) WritePayload(payload ipc.Payload) error {
+	var body bytes.Buffer
+	if err := payload.SerializeBody(&body); err != nil {
+		return fmt.Errorf("flight: failed to serialize message body: %w", err)
+	}
+	data := &pb.FlightData{
+		FlightDescriptor: w.descriptor,
+		DataHeader:       payload.Meta().Bytes(),
+		DataBody:         body.Bytes(),
+	}
+	w.descriptor = nil
+	return w.stream.Send(data)
+}
+
+func (w *flightPayloadWriter) Close() error { return nil }
+
+// recordBatchWriter adapts an arrio.Writer of array.Record onto a stream of
+// FlightData messages: ipc.Writer builds the metadata and body of each IPC
+// message as usual, and flightPayloadWriter ships the result out as a
+// FlightData frame instead of appending it to an io.Writer.
+type recordBatchWriter struct {
+	pw *flightPayloadWriter
+	wr *ipc.Writer
+}
+
+// RecordWriter is an arrio.Writer that must be closed once the caller is
+// done writing record batches, so that the underlying IPC stream can flush
+// its end-of-stream marker.
+type RecordWriter interface {
+	arrio.Writer
+	Close() error
+}
+
+// newRecordBatchWriter builds a RecordWriter that encodes batches of schema
+// as FlightData onto stream. descriptor, if non-nil, is attached to the
+// first frame as required by DoPut.
+func newRecordBatchWriter(stream dataStreamWriter, descriptor *pb.FlightDescriptor, schema *arrow.Schema) (RecordWriter, error) {
+	pw := &flightPayloadWriter{stream: stream, descriptor: descriptor}
+	wr := ipc.NewWriterWithPayloadWriter(pw, ipc.WithSchema(schema))
+	return &recordBatchWriter{pw: pw, wr: wr}, nil
+}
+
+// Write encodes rec and sends it to the peer.
+func (w *recordBatchWriter) Write(rec array.Record) error {
+	if err := w.wr.Write(rec); err != nil {
+		return fmt.Errorf("flight: failed writing record batch: %w", err)
+	}
+	return nil
+}
+
+// Close flushes any buffered state and terminates the underlying IPC
+// stream.
+func (w *recordBatchWriter) Close() error {
+	return w.wr.Close()
+}
@@ -0,0 +1,144 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flight
+
+import (
+	"context"
+	"net"
+
+	"github.com/apache/arrow/go/arrow"
+	pb "github.com/apache/arrow/go/arrow/flight/internal/flight"
+	"github.com/apache/arrow/go/arrow/internal/arrio"
+	"github.com/apache/arrow/go/arrow/memory"
+	"google.golang.org/grpc"
+)
+
+// FlightService is implemented by anything that wants to serve Arrow Flight
+// requests. Each method corresponds directly to one RPC in Flight.proto;
+// Server handles the gRPC plumbing and delegates to a FlightService
+// implementation for everything else.
+//
+// Implementations that don't support a given RPC should embed
+// BaseFlightService, which answers every method with codes.Unimplemented.
+type FlightService interface {
+	Handshake(HandshakeServer) error
+	ListFlights(*Criteria, ListFlightsServer) error
+	GetFlightInfo(context.Context, *FlightDescriptor) (*FlightInfo, error)
+	GetSchema(context.Context, *FlightDescriptor) (*SchemaResult, error)
+	DoGet(*Ticket, DoGetServer) error
+	DoPut(DoPutServer) error
+	DoExchange(DoExchangeServer) error
+	DoAction(*Action, DoActionServer) error
+	ListActions(*Empty, ListActionsServer) error
+}
+
+// BaseFlightService answers every FlightService RPC with
+// codes.Unimplemented, so that implementations only need to override the
+// methods they actually support.
+type BaseFlightService struct {
+	pb.UnimplementedFlightServiceServer
+}
+
+var _ FlightService = (*BaseFlightService)(nil)
+
+// Server wraps a FlightService implementation and exposes it as a Flight
+// RPC endpoint over gRPC.
+type Server struct {
+	grpcServer *grpc.Server
+	svc        FlightService
+}
+
+// NewServer builds a Server that dispatches incoming RPCs to svc. Any
+// grpc.ServerOption is forwarded to the underlying grpc.Server, so callers
+// can configure TLS, interceptors, and so on exactly as they would for a
+// plain gRPC service.
+func NewServer(svc FlightService, opts ...grpc.ServerOption) *Server {
+	s := &Server{svc: svc, grpcServer: grpc.NewServer(opts...)}
+	pb.RegisterFlightServiceServer(s.grpcServer, (*flightServiceServer)(s))
+	return s
+}
+
+// Serve accepts incoming connections on lis and blocks until the server is
+// stopped or lis is closed.
+func (s *Server) Serve(lis net.Listener) error {
+	return s.grpcServer.Serve(lis)
+}
+
+// GracefulStop stops the server, letting in-flight RPCs finish.
+func (s *Server) GracefulStop() {
+	s.grpcServer.GracefulStop()
+}
+
+// Stop stops the server immediately, terminating any in-flight RPCs.
+func (s *Server) Stop() {
+	s.grpcServer.Stop()
+}
+
+// RecordBatchWriter returns a RecordWriter that sends record batches
+// matching schema as FlightData on stream. It's a convenience for
+// FlightService implementations responding to DoGet/DoExchange.
+func RecordBatchWriter(stream DoGetServer, schema *arrow.Schema) (RecordWriter, error) {
+	return newRecordBatchWriter(stream, nil, schema)
+}
+
+// RecordBatchReader returns an arrio.Reader that decodes the FlightData
+// sent by the peer on stream into array.Record values, allocating with mem
+// (memory.DefaultAllocator if nil). It's a convenience for FlightService
+// implementations handling DoPut/DoExchange.
+func RecordBatchReader(stream dataStreamReader, mem memory.Allocator) (arrio.Reader, error) {
+	return newRecordBatchReader(stream, mem)
+}
+
+// flightServiceServer adapts a *Server to the generated pb.FlightServiceServer
+// interface by forwarding every call to the user-supplied FlightService.
+type flightServiceServer Server
+
+func (s *flightServiceServer) Handshake(stream HandshakeServer) error {
+	return s.svc.Handshake(stream)
+}
+
+func (s *flightServiceServer) ListFlights(c *Criteria, stream ListFlightsServer) error {
+	return s.svc.ListFlights(c, stream)
+}
+
+func (s *flightServiceServer) GetFlightInfo(ctx context.Context, desc *FlightDescriptor) (*FlightInfo, error) {
+	return s.svc.GetFlightInfo(ctx, desc)
+}
+
+func (s *flightServiceServer) GetSchema(ctx context.Context, desc *FlightDescriptor) (*SchemaResult, error) {
+	return s.svc.GetSchema(ctx, desc)
+}
+
+func (s *flightServiceServer) DoGet(t *Ticket, stream DoGetServer) error {
+	return s.svc.DoGet(t, stream)
+}
+
+func (s *flightServiceServer) DoPut(stream DoPutServer) error {
+	return s.svc.DoPut(stream)
+}
+
+func (s *flightServiceServer) DoExchange(stream DoExchangeServer) error {
+	return s.svc.DoExchange(stream)
+}
+
+func (s *flightServiceServer) DoAction(a *Action, stream DoActionServer) error {
+	return s.svc.DoAction(a, stream)
+}
+
+func (s *flightServiceServer) ListActions(e *Empty, stream ListActionsServer) error {
+	return s.svc.ListActions(e, stream)
+}
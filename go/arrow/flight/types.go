@@ -0,0 +1,55 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package flight
+
+import pb "github.com/apache/arrow/go/arrow/flight/internal/flight"
+
+// These aliases re-export the generated protobuf message and stream types
+// under the flight package, so that callers implementing FlightService
+// never need to import the internal/flight package directly.
+type (
+	HandshakeRequest  = pb.HandshakeRequest
+	HandshakeResponse = pb.HandshakeResponse
+	BasicAuth         = pb.BasicAuth
+	Empty             = pb.Empty
+	ActionType        = pb.ActionType
+	Criteria          = pb.Criteria
+	Action            = pb.Action
+	Result            = pb.Result
+	SchemaResult      = pb.SchemaResult
+	FlightDescriptor  = pb.FlightDescriptor
+	FlightInfo        = pb.FlightInfo
+	FlightEndpoint    = pb.FlightEndpoint
+	Location          = pb.Location
+	Ticket            = pb.Ticket
+	FlightData        = pb.FlightData
+	PutResult         = pb.PutResult
+
+	HandshakeServer   = pb.FlightService_HandshakeServer
+	ListFlightsServer = pb.FlightService_ListFlightsServer
+	DoGetServer       = pb.FlightService_DoGetServer
+	DoPutServer       = pb.FlightService_DoPutServer
+	DoExchangeServer  = pb.FlightService_DoExchangeServer
+	DoActionServer    = pb.FlightService_DoActionServer
+	ListActionsServer = pb.FlightService_ListActionsServer
+)
+
+const (
+	DescriptorUNKNOWN = pb.FlightDescriptor_UNKNOWN
+	DescriptorPATH    = pb.FlightDescriptor_PATH
+	DescriptorCMD     = pb.FlightDescriptor_CMD
+)
@@ -0,0 +1,72 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ipc
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/apache/arrow/go/arrow/internal/flatbuf"
+	"github.com/apache/arrow/go/arrow/ipc/compress"
+)
+
+// compressBodyBuffer compresses a single body buffer per cfg's configured
+// compressor, prefixing it with its 8-byte little-endian uncompressed
+// length as the Arrow IPC format requires ahead of every compressed body
+// buffer. With no compressor configured (cfg.compressor is nil), buf is
+// returned unchanged.
+//
+// The message writer (ipc.Writer, in writer.go) is the intended caller: once
+// per body buffer, immediately before appending it to a message, it would
+// call this and set the message's BodyCompression metadata to
+// cfg.compressor.Type() when compression was applied. writer.go is not part
+// of this tree, so that call site does not exist yet here; WithCompression
+// has no effect on any stream until it's added there.
+func compressBodyBuffer(cfg *config, buf []byte) ([]byte, error) {
+	if cfg.compressor == nil {
+		return buf, nil
+	}
+	out := make([]byte, 8, 8+len(buf))
+	binary.LittleEndian.PutUint64(out, uint64(len(buf)))
+	return cfg.compressor.Compress(out, buf)
+}
+
+// decompressBodyBuffer reverses compressBodyBuffer. comp is the buffer's
+// message's BodyCompression metadata, or nil if the message carried none, in
+// which case buf is returned unchanged. Otherwise buf is expected to carry
+// the 8-byte uncompressed-length prefix compressBodyBuffer adds, followed by
+// the compressed bytes; the codec is looked up in the ipc/compress registry
+// by comp's CompressionType so that third-party codecs registered there are
+// honored transparently.
+//
+// As with compressBodyBuffer, the intended caller is the message reader
+// (ipc.Reader, in reader.go) decoding each body buffer in turn; reader.go is
+// not part of this tree, so that call site does not exist here either.
+func decompressBodyBuffer(comp *flatbuf.BodyCompression, buf []byte) ([]byte, error) {
+	if comp == nil {
+		return buf, nil
+	}
+	if len(buf) < 8 {
+		return nil, fmt.Errorf("arrow/ipc: compressed body buffer too short to contain uncompressed-length prefix")
+	}
+	uncompressedLen := binary.LittleEndian.Uint64(buf[:8])
+	codec, err := compress.GetCodec(comp.Codec())
+	if err != nil {
+		return nil, err
+	}
+	return codec.Decompress(make([]byte, 0, uncompressedLen), buf[8:])
+}
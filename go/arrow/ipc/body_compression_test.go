@@ -0,0 +1,62 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ipc
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow/internal/flatbuf"
+	"github.com/apache/arrow/go/arrow/ipc/compress"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCompressBodyBufferPrefixesUncompressedLength(t *testing.T) {
+	codec, err := compress.GetCodec(flatbuf.CompressionTypeLZ4_FRAME)
+	require.NoError(t, err)
+
+	cfg := &config{compressor: codec}
+	payload := bytes.Repeat([]byte("arrow-ipc-body-compression"), 64)
+
+	compressed, err := compressBodyBuffer(cfg, payload)
+	require.NoError(t, err)
+	require.True(t, len(compressed) >= 8)
+	assert.Equal(t, uint64(len(payload)), binary.LittleEndian.Uint64(compressed[:8]))
+
+	decompressed, err := codec.Decompress(nil, compressed[8:])
+	require.NoError(t, err)
+	assert.Equal(t, payload, decompressed)
+}
+
+func TestCompressBodyBufferNoCodecIsNoop(t *testing.T) {
+	cfg := &config{}
+	payload := []byte("uncompressed")
+
+	out, err := compressBodyBuffer(cfg, payload)
+	require.NoError(t, err)
+	assert.Equal(t, payload, out)
+}
+
+func TestDecompressBodyBufferNilMetadataIsNoop(t *testing.T) {
+	payload := []byte("uncompressed")
+
+	out, err := decompressBodyBuffer(nil, payload)
+	require.NoError(t, err)
+	assert.Equal(t, payload, out)
+}
@@ -0,0 +1,88 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package compress implements the body-compression codecs used by the IPC
+// stream and file formats, as identified by the Arrow BodyCompression
+// flatbuffer. Codecs are looked up by flatbuf.CompressionType through a
+// package-level registry, so that the IPC reader and writer never need to
+// know about a given codec's implementation.
+package compress
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/apache/arrow/go/arrow/internal/flatbuf"
+)
+
+// Codec compresses and decompresses the body of a single IPC message. Both
+// methods follow the append-to-dst convention used throughout this module:
+// dst is grown and returned, and may be nil.
+type Codec interface {
+	// Compress appends the compressed form of src to dst and returns the
+	// result.
+	Compress(dst, src []byte) ([]byte, error)
+	// Decompress appends the decompressed form of src to dst and returns
+	// the result. The caller is expected to know (from the IPC message
+	// metadata) the decompressed length and size dst accordingly.
+	Decompress(dst, src []byte) ([]byte, error)
+	// Type identifies the codec as one of the flatbuf.CompressionType
+	// values, for inclusion in the BodyCompression metadata.
+	Type() flatbuf.CompressionType
+}
+
+var (
+	mu       sync.RWMutex
+	registry = make(map[flatbuf.CompressionType]Codec)
+)
+
+// Register associates codec with its Type() in the package-level registry,
+// overwriting any codec previously registered for that type. It is safe to
+// call Register from an init func, including from outside this module, so
+// that downstream projects can plug in codecs (e.g. Snappy, or a
+// hardware-accelerated implementation) without patching Arrow.
+func Register(codec Codec) {
+	mu.Lock()
+	defer mu.Unlock()
+	registry[codec.Type()] = codec
+}
+
+// GetCodec returns the codec registered for typ, or an error if none has
+// been registered.
+func GetCodec(typ flatbuf.CompressionType) (Codec, error) {
+	mu.RLock()
+	defer mu.RUnlock()
+	codec, ok := registry[typ]
+	if !ok {
+		return nil, fmt.Errorf("arrow/ipc/compress: no codec registered for compression type %s", typ)
+	}
+	return codec, nil
+}
+
+// None is a passthrough codec: Compress and Decompress both simply append
+// src to dst unchanged. It is useful for testing the compression machinery
+// itself, and as an explicit opt-out when a BodyCompression-aware reader is
+// talking to a writer that would otherwise compress by default. Because the
+// Arrow BodyCompression flatbuffer has no "uncompressed" enum value, None is
+// not part of the Type-keyed registry: the absence of a BodyCompression
+// entry in a message's metadata is what actually signals "uncompressed" on
+// the wire.
+var None Codec = noneCodec{}
+
+func init() {
+	Register(lz4Codec{})
+	Register(zstdCodec{})
+}
@@ -0,0 +1,88 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compress_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/apache/arrow/go/arrow/internal/flatbuf"
+	"github.com/apache/arrow/go/arrow/ipc/compress"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRegisteredCodecsRoundTrip(t *testing.T) {
+	tests := []struct {
+		name string
+		typ  flatbuf.CompressionType
+	}{
+		{"lz4", flatbuf.CompressionTypeLZ4_FRAME},
+		{"zstd", flatbuf.CompressionTypeZSTD},
+	}
+
+	payload := bytes.Repeat([]byte("arrow-flight-compress-round-trip"), 64)
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			codec, err := compress.GetCodec(tt.typ)
+			require.NoError(t, err)
+			assert.Equal(t, tt.typ, codec.Type())
+
+			compressed, err := codec.Compress(nil, payload)
+			require.NoError(t, err)
+
+			decompressed, err := codec.Decompress(nil, compressed)
+			require.NoError(t, err)
+			assert.Equal(t, payload, decompressed)
+		})
+	}
+}
+
+func TestNoneCodecIsPassthrough(t *testing.T) {
+	payload := []byte("uncompressed")
+	compressed, err := compress.None.Compress(nil, payload)
+	require.NoError(t, err)
+	assert.Equal(t, payload, compressed)
+
+	decompressed, err := compress.None.Decompress(nil, compressed)
+	require.NoError(t, err)
+	assert.Equal(t, payload, decompressed)
+}
+
+func TestGetCodecUnregistered(t *testing.T) {
+	_, err := compress.GetCodec(flatbuf.CompressionType(99))
+	assert.Error(t, err)
+}
+
+type customCodec struct{}
+
+func (customCodec) Compress(dst, src []byte) ([]byte, error)   { return append(dst, src...), nil }
+func (customCodec) Decompress(dst, src []byte) ([]byte, error) { return append(dst, src...), nil }
+func (customCodec) Type() flatbuf.CompressionType              { return flatbuf.CompressionTypeLZ4_FRAME }
+
+func TestRegisterOverridesBuiltin(t *testing.T) {
+	original, err := compress.GetCodec(flatbuf.CompressionTypeLZ4_FRAME)
+	require.NoError(t, err)
+	defer compress.Register(original)
+
+	compress.Register(customCodec{})
+	codec, err := compress.GetCodec(flatbuf.CompressionTypeLZ4_FRAME)
+	require.NoError(t, err)
+	assert.IsType(t, customCodec{}, codec)
+}
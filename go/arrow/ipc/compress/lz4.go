@@ -0,0 +1,55 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compress
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/apache/arrow/go/arrow/internal/flatbuf"
+	"github.com/pierrec/lz4/v4"
+)
+
+// lz4Codec implements the LZ4_FRAME member of flatbuf.CompressionType using
+// the LZ4 frame format from github.com/pierrec/lz4/v4, which is what the
+// Arrow IPC format requires (as opposed to the raw/block LZ4 format).
+type lz4Codec struct{}
+
+func (lz4Codec) Compress(dst, src []byte) ([]byte, error) {
+	buf := bytes.NewBuffer(dst)
+	w := lz4.NewWriter(buf)
+	if _, err := w.Write(src); err != nil {
+		return nil, fmt.Errorf("arrow/ipc/compress: lz4 compress: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return nil, fmt.Errorf("arrow/ipc/compress: lz4 compress: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+func (lz4Codec) Decompress(dst, src []byte) ([]byte, error) {
+	buf := bytes.NewBuffer(dst)
+	r := lz4.NewReader(bytes.NewReader(src))
+	if _, err := buf.ReadFrom(r); err != nil {
+		return nil, fmt.Errorf("arrow/ipc/compress: lz4 decompress: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+func (lz4Codec) Type() flatbuf.CompressionType {
+	return flatbuf.CompressionTypeLZ4_FRAME
+}
@@ -0,0 +1,79 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package compress
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/apache/arrow/go/arrow/internal/flatbuf"
+	"github.com/klauspost/compress/zstd"
+)
+
+// zstdCodec implements the ZSTD member of flatbuf.CompressionType using
+// klauspost/compress/zstd. The encoder and decoder are expensive to set up,
+// so each is built once, lazily, and reused across calls; both are safe for
+// concurrent use per the klauspost/compress/zstd documentation.
+type zstdCodec struct{}
+
+var (
+	zstdEncOnce sync.Once
+	zstdEnc     *zstd.Encoder
+	zstdEncErr  error
+
+	zstdDecOnce sync.Once
+	zstdDec     *zstd.Decoder
+	zstdDecErr  error
+)
+
+func getZstdEncoder() (*zstd.Encoder, error) {
+	zstdEncOnce.Do(func() {
+		zstdEnc, zstdEncErr = zstd.NewWriter(nil)
+	})
+	return zstdEnc, zstdEncErr
+}
+
+func getZstdDecoder() (*zstd.Decoder, error) {
+	zstdDecOnce.Do(func() {
+		zstdDec, zstdDecErr = zstd.NewReader(nil)
+	})
+	return zstdDec, zstdDecErr
+}
+
+func (zstdCodec) Compress(dst, src []byte) ([]byte, error) {
+	enc, err := getZstdEncoder()
+	if err != nil {
+		return nil, fmt.Errorf("arrow/ipc/compress: zstd compress: %w", err)
+	}
+	return enc.EncodeAll(src, dst), nil
+}
+
+func (zstdCodec) Decompress(dst, src []byte) ([]byte, error) {
+	dec, err := getZstdDecoder()
+	if err != nil {
+		return nil, fmt.Errorf("arrow/ipc/compress: zstd decompress: %w", err)
+	}
+	out, err := dec.DecodeAll(src, dst)
+	if err != nil {
+		return nil, fmt.Errorf("arrow/ipc/compress: zstd decompress: %w", err)
+	}
+	return out, nil
+}
+
+func (zstdCodec) Type() flatbuf.CompressionType {
+	return flatbuf.CompressionTypeZSTD
+}
@@ -0,0 +1,36 @@
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+// http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package ipc
+
+import "github.com/apache/arrow/go/arrow/ipc/compress"
+
+// WithCompression configures a Writer to compress record batch bodies with
+// codec before writing them, and to set the corresponding BodyCompression
+// metadata on each message so that a Reader can look the same codec back up
+// through the ipc/compress registry, with no out-of-band configuration
+// required on the read side. See compressBodyBuffer/decompressBodyBuffer in
+// body_compression.go for the wire format this relies on, and their doc
+// comments for why that plumbing has no effect yet: writer.go and reader.go,
+// where cfg.compressor would actually be read, aren't part of this tree.
+//
+// The zero value (no WithCompression option) writes uncompressed bodies,
+// matching prior behavior. config.codec already holds the
+// flatbuf.CompressionType a Reader falls back to for legacy, pre-registry
+// streams, so the chosen Codec is kept in its own field instead.
+func WithCompression(codec compress.Codec) Option {
+	return func(cfg *config) { cfg.compressor = codec }
+}